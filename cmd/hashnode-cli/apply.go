@@ -3,15 +3,13 @@ package main
 import (
 	"context"
 	"fmt"
-	"net/http"
+	"strings"
 
-	"github.com/Khan/genqlient/graphql"
 	"github.com/spf13/cobra"
 
-	"adil-adysh/hashnode-cli/internal/api"
-	"adil-adysh/hashnode-cli/internal/applyutil"
-	"adil-adysh/hashnode-cli/internal/config"
 	"adil-adysh/hashnode-cli/internal/diff"
+	"adil-adysh/hashnode-cli/internal/lint"
+	"adil-adysh/hashnode-cli/internal/provider"
 	"adil-adysh/hashnode-cli/internal/state"
 )
 
@@ -22,7 +20,7 @@ var applyCmd = &cobra.Command{
 	Short: "Apply planned changes",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if applyDryRun {
-			fmt.Println("apply: dry-run (no API calls, no writes)")
+			fmt.Println("apply: dry-run (read-only API calls, no writes)")
 		}
 
 		// Acquire repo lock
@@ -42,17 +40,10 @@ var applyCmd = &cobra.Command{
 			}
 		}()
 
-		// Load user config for token
-		cfg, err := config.Load()
+		client, err := newAPIClient()
 		if err != nil {
-			return fmt.Errorf("failed to load home config (run init): %w", err)
+			return err
 		}
-		if cfg.Token == "" {
-			return fmt.Errorf("no token configured; run 'hashnode init'")
-		}
-
-		httpClient := &http.Client{Transport: &authedTransport{token: cfg.Token, wrapped: http.DefaultTransport}}
-		client := graphql.NewClient("https://gql.hashnode.com", httpClient)
 
 		// Load stage and determine which paths are staged
 		st, err := state.LoadStage()
@@ -65,9 +56,7 @@ var applyCmd = &cobra.Command{
 			return nil
 		}
 
-		// Compute plan from the Stage (intent) and Ledger (articles)
-		// Load or construct sum (ledger) and build the registry slice from it.
-		var articles []diff.RegistryEntry
+		// Compute plan from the Stage (intent) and Ledger (hashnode.sum)
 		var s *state.Sum
 		if ss, err := state.LoadSum(); err == nil {
 			if err := ss.ValidateAgainstBlog(); err == nil {
@@ -77,255 +66,67 @@ var applyCmd = &cobra.Command{
 		if s == nil {
 			s, _ = state.NewSumFromBlog()
 		}
-
-		for path, a := range s.Articles {
-			articles = append(articles, diff.RegistryEntry{
-				MarkdownPath: path,
-				RemotePostID: a.PostID,
-				Checksum:     a.Checksum,
-			})
+		if s == nil {
+			s = &state.Sum{}
 		}
 
-		plan := diff.GeneratePlan(articles, st)
+		parallel := applyParallel
+		if !cmd.Flags().Changed("parallel") && repoCfg.Apply.Parallel > 0 {
+			parallel = repoCfg.Apply.Parallel
+		}
+		env := &provider.Env{
+			Ctx:           context.Background(),
+			Client:        client,
+			Sum:           s,
+			Stage:         st,
+			Repo:          repoCfg,
+			Yes:           applyYes,
+			RedirectSlugs: applyRedirectSlugs,
+			Parallel:      parallel,
+		}
+		plan, err := provider.BuildPlan(env)
+		if err != nil {
+			return err
+		}
 
 		if applyDryRun {
-			createCount, updateCount, deleteCount, skipCount := 0, 0, 0, 0
-			for _, it := range plan {
-				switch it.Type {
-				case diff.ActionCreate:
-					createCount++
-				case diff.ActionUpdate:
-					updateCount++
-				case diff.ActionDelete:
-					deleteCount++
-				case diff.ActionSkip:
-					skipCount++
-				}
-				reason := it.Reason
-				target := it.Path
-				if it.OldPath != "" {
-					target = fmt.Sprintf("%s (from %s)", it.Path, it.OldPath)
-				}
-				symbol := ""
-				switch it.Type {
-				case diff.ActionCreate:
-					symbol = "🟢"
-				case diff.ActionUpdate:
-					symbol = "🟡"
-				case diff.ActionDelete:
-					symbol = "🔴"
-				case diff.ActionSkip:
-					symbol = "⚪"
-				}
-				if reason != "" {
-					fmt.Printf("%s %-6s %s — %s\n", symbol, it.Type, target, reason)
-				} else {
-					fmt.Printf("%s %-6s %s\n", symbol, it.Type, target)
-				}
-			}
-			fmt.Printf("Summary: %d create, %d update, %d delete, %d skip\n", createCount, updateCount, deleteCount, skipCount)
+			printDryRun(plan)
 			return nil
 		}
 
-		// Validate planned creations for missing/too-short titles before contacting API
-		var bad []string
-		for _, it := range plan {
-			if it.Type != diff.ActionCreate {
-				continue
+		// Lint staged content before contacting the API
+		if !applyNoLint {
+			sources, lerr := lint.FromStage(st)
+			if lerr != nil {
+				return lerr
 			}
-
-			// Resolve title using centralized function
-			title, _ := state.ResolveTitleForPath(it.Path, s, st)
-
-			// If title still empty or too short, record as bad
-			if len(title) < 6 {
-				bad = append(bad, it.Path)
+			if lint.HasErrors(runLint(sources)) {
+				return fmt.Errorf("lint failed for staged content; fix the errors above and re-stage, or re-run with --no-lint")
 			}
 		}
-		if len(bad) > 0 {
-			return fmt.Errorf("the following staged files are missing valid titles (>=6 chars): %v. Add a 'title:' field to their frontmatter", bad)
-		}
-
-		// Build set of staged include paths for quick reference
-		stagedPaths := make(map[string]struct{})
-		for p := range st.Items {
-			stagedPaths[state.NormalizePath(p)] = struct{}{}
-		}
 
-		// `s` (ledger) was loaded above when generating the plan.
+		res, applyErr := provider.Apply(env, plan)
 
-		// Build lookup from existing staged registry metadata
-		regByPath := make(map[string]diff.RegistryEntry)
-		for _, a := range articles {
-			regByPath[state.NormalizePath(a.MarkdownPath)] = a
+		// Persist updated sum (ledger) - single write. On failure this records
+		// what was applied so a re-run does not repeat it.
+		if err := state.SaveSum(s); err != nil {
+			return fmt.Errorf("failed to save hashnode.sum: %w", err)
 		}
-
-		// Collect ledger updates to apply atomically at end
-		type LedgerUpdate struct {
-			path     string
-			postID   string
-			checksum string
-			slug     string
-			title    string
-			isDelete bool
+		if applyErr != nil {
+			return applyErr
 		}
-		var ledgerUpdates []LedgerUpdate
-
-		// Apply plan items in order
-		for _, it := range plan {
-			np := state.NormalizePath(it.Path)
-			switch it.Type {
-			case diff.ActionSkip:
-				// nothing to do
-				continue
-			case diff.ActionDelete:
-				// delete remote post if exists
-				var remoteID string
-				if it.RemoteID != "" {
-					remoteID = it.RemoteID
-				} else if e, ok := regByPath[np]; ok {
-					remoteID = e.RemotePostID
-				}
-				if remoteID == "" {
-					// nothing to delete
-					continue
-				}
-				if !applyYes {
-					return fmt.Errorf("deletion required for %s (remote id=%s). Re-run with --yes to confirm deletions", it.Path, remoteID)
-				}
-				if _, derr := api.RemovePost(context.Background(), client, api.RemovePostInput{Id: remoteID}); derr != nil {
-					return fmt.Errorf("delete failed for %s (remote id=%s): %w", it.Path, remoteID, derr)
-				}
-				// Queue ledger update
-				ledgerUpdates = append(ledgerUpdates, LedgerUpdate{path: np, isDelete: true})
-				fmt.Printf("Deleted post %s -> %s\n", it.Path, remoteID)
-			case diff.ActionUpdate:
-				// find remote id and local metadata
-				var entry diff.RegistryEntry
-				var ok bool
-				if entry, ok = regByPath[np]; !ok && it.OldPath != "" {
-					entry, ok = regByPath[state.NormalizePath(it.OldPath)]
-				}
-				if !ok {
-					// nothing to update (shouldn't happen)
-					continue
-				}
-				// staleness check using new staged item schema
-				if si, ok := st.Items[np]; ok {
-					if state.IsStagingItemStale(si, it.Path) {
-						if !applyYes {
-							return fmt.Errorf("staged content changed for %s; re-stage or rerun with --yes to force", it.Path)
-						}
-						fmt.Printf("warning: forcing apply despite staged content changes for %s\n", it.Path)
-					}
-				}
-				// Load content from snapshot when available, otherwise disk
-				fm, content, rerr := applyutil.LoadContentForPath(st, it.Path)
-				if rerr != nil {
-					return rerr
-				}
 
-				// Resolve title using centralized function
-				title, _ := state.ResolveTitleForPath(it.Path, s, st)
-				if title == "" {
-					return fmt.Errorf("no title found for %s", it.Path)
-				}
-
-				// perform update via API (include title)
-				if s == nil || s.Blog.PublicationID == "" {
-					return fmt.Errorf("update failed for %s: publication id missing in ledger; run 'hashnode init'", it.Path)
-				}
-				pubID := s.Blog.PublicationID
-				input := api.UpdatePostInput{Id: entry.RemotePostID, ContentMarkdown: &content, Title: &title, PublicationId: &pubID}
-				applyutil.ApplyFrontmatterToUpdateInput(&input, fm, s)
-				if _, uerr := api.UpdatePost(context.Background(), client, input); uerr != nil {
-					return fmt.Errorf("update failed for %s: %w", it.Path, uerr)
-				}
-
-				// Determine checksum to store
-				var checksum string
-				if stored, ok := st.Items[np]; ok && stored.Checksum != "" {
-					checksum = stored.Checksum
-				} else {
-					checksum = state.ChecksumFromContent([]byte(content))
-				}
-				// Preserve existing slug if present in the ledger
-				slug := ""
-				if le, ok := s.Articles[np]; ok {
-					slug = le.Slug
-				}
-				// Queue ledger update
-				ledgerUpdates = append(ledgerUpdates, LedgerUpdate{
-					path:     np,
-					postID:   entry.RemotePostID,
-					checksum: checksum,
-					slug:     slug,
-					title:    title,
-				})
-				fmt.Printf("Updated post %s -> %s\n", it.Path, entry.RemotePostID)
-			case diff.ActionCreate:
-				fm, content, rerr := applyutil.LoadContentForPath(st, it.Path)
-				if rerr != nil {
-					return rerr
-				}
-
-				// Resolve title using centralized function
-				title, _ := state.ResolveTitleForPath(it.Path, s, st)
-				if title == "" {
-					return fmt.Errorf("no title found for %s", it.Path)
-				}
-
-				input := api.PublishPostInput{Title: title, PublicationId: s.Blog.PublicationID, ContentMarkdown: content}
-				applyutil.ApplyFrontmatterToPublishInput(&input, fm, s)
-				resp, perr := api.PublishPost(context.Background(), client, input)
-				if perr != nil {
-					return fmt.Errorf("publish failed for %s: %w", it.Path, perr)
-				}
-				if resp == nil || resp.PublishPost.Post.Id == "" {
-					return fmt.Errorf("publish returned no id for %s", it.Path)
-				}
-				newID := resp.PublishPost.Post.Id
-
-				var checksum string
-				if si, ok := st.Items[np]; ok && si.Checksum != "" {
-					checksum = si.Checksum
-				} else {
-					checksum = state.ChecksumFromContent([]byte(content))
-				}
-
-				// Record slug returned by publish API
-				pubSlug := ""
-				if resp != nil && resp.PublishPost.Post != nil {
-					pubSlug = resp.PublishPost.Post.Slug
-				}
-				// Queue ledger update
-				ledgerUpdates = append(ledgerUpdates, LedgerUpdate{
-					path:     np,
-					postID:   newID,
-					checksum: checksum,
-					slug:     pubSlug,
-					title:    title,
-				})
-				fmt.Printf("Created post %s -> %s\n", it.Path, newID)
-			}
-		}
-
-		// Apply all ledger updates atomically
-		for _, update := range ledgerUpdates {
-			if update.isDelete {
-				s.RemoveArticle(update.path)
-			} else {
-				s.SetArticleWithTitle(update.path, update.postID, update.checksum, update.slug, update.title)
+		// Clear stage on success, keeping items the API could not apply
+		kept := make(map[string]state.StagedItem)
+		for _, it := range res.Unsupported {
+			if item, ok := st.Items[it.Path]; ok {
+				kept[it.Path] = item
 			}
 		}
-
-		// Persist updated sum (ledger) - single write
-		if err := state.SaveSum(s); err != nil {
-			return fmt.Errorf("failed to save hashnode.sum: %w", err)
-		}
-
-		// Clear stage on success
 		st.Clear()
+		for p, item := range kept {
+			st.Items[p] = item
+		}
 		if err := state.SaveStage(st); err != nil {
 			return fmt.Errorf("failed to clear stage: %w", err)
 		}
@@ -343,10 +144,54 @@ var applyCmd = &cobra.Command{
 	},
 }
 
+// printDryRun lists the plan in apply order with a summary line.
+func printDryRun(plan []diff.PlanItem) {
+	createCount, updateCount, deleteCount, skipCount := 0, 0, 0, 0
+	for _, it := range plan {
+		symbol := ""
+		switch it.Type {
+		case diff.ActionCreate:
+			createCount++
+			symbol = "🟢"
+		case diff.ActionUpdate:
+			updateCount++
+			symbol = "🟡"
+		case diff.ActionDelete:
+			deleteCount++
+			symbol = "🔴"
+		case diff.ActionSkip:
+			skipCount++
+			symbol = "⚪"
+		}
+		target := it.Path
+		if it.Kind != "" {
+			target = fmt.Sprintf("%s (%s)", it.Title, it.Path)
+		} else if it.OldPath != "" {
+			target = fmt.Sprintf("%s (from %s)", it.Path, it.OldPath)
+		}
+		if len(it.DependsOn) > 0 {
+			target += " [after " + strings.Join(it.DependsOn, ", ") + "]"
+		}
+		if it.Reason != "" {
+			fmt.Printf("%s %-6s %s — %s\n", symbol, it.Type, target, it.Reason)
+		} else {
+			fmt.Printf("%s %-6s %s\n", symbol, it.Type, target)
+		}
+	}
+	fmt.Printf("Summary: %d create, %d update, %d delete, %d skip\n", createCount, updateCount, deleteCount, skipCount)
+}
+
 var applyYes bool
 var applyDryRun bool
+var applyNoLint bool
+var applyRedirectSlugs bool
+var applyParallel int
 
 func init() {
 	applyCmd.Flags().BoolVarP(&applyYes, "yes", "y", false, "Confirm and perform destructive deletions (required to remove remote posts)")
 	applyCmd.Flags().BoolVar(&applyDryRun, "dry-run", false, "Preview apply without calling the API or writing state")
+	applyCmd.Flags().BoolVar(&applyNoLint, "no-lint", false, "Skip lint checks on staged content")
+	applyCmd.Flags().BoolVar(&applyRedirectSlugs, "redirect-slugs", false, "Create a permanent redirect when an article's slug changes")
+	applyCmd.Flags().IntVar(&applyParallel, "parallel", 1, "Apply independent items concurrently, at most N at a time (overrides apply.parallel)")
+	applyCmd.Flags().Lookup("parallel").NoOptDefVal = "4"
 }
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Khan/genqlient/graphql"
+
+	"adil-adysh/hashnode-cli/internal/config"
+)
+
+// newAPIClient returns a GraphQL client for the user's endpoint (see
+// config.Config.Endpoint), authenticated with the token from the home config.
+func newAPIClient() (graphql.Client, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load home config (run init): %w", err)
+	}
+	if cfg.Token == "" {
+		return nil, fmt.Errorf("no token configured; run 'hashnode init'")
+	}
+	endpoint, err := cfg.Endpoint()
+	if err != nil {
+		return nil, err
+	}
+	httpClient := &http.Client{Transport: &authedTransport{token: cfg.Token, wrapped: http.DefaultTransport}}
+	return graphql.NewClient(endpoint, httpClient), nil
+}
@@ -0,0 +1,321 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"adil-adysh/hashnode-cli/internal/api"
+	"adil-adysh/hashnode-cli/internal/applyutil"
+	"adil-adysh/hashnode-cli/internal/diff"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+var diffStaged bool
+var diffRemote bool
+var diffStat bool
+
+var diffCmd = &cobra.Command{
+	Use:   "diff [path]",
+	Short: "Show what changed since the last publish",
+	Long: `Show a unified diff of article bodies and a list of changed frontmatter
+fields.
+
+By default the working file is compared with the content last published
+from this repo (kept as a snapshot under its hashnode.sum checksum).
+  --staged   compare the staged snapshot instead of the working file
+  --remote   compare against the live post on Hashnode instead of the ledger
+  --stat     print a per-file summary instead of the diff
+
+Without a path every tracked or staged article and static page is compared.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		sum, err := state.LoadSum()
+		if err != nil {
+			if !os.IsNotExist(err) {
+				return fmt.Errorf("failed to load hashnode.sum: %w", err)
+			}
+			sum = &state.Sum{}
+		}
+		st, err := state.LoadStage()
+		if err != nil {
+			return fmt.Errorf("failed to load stage: %w", err)
+		}
+
+		var paths []string
+		if len(args) == 1 {
+			paths = []string{state.NormalizePath(args[0])}
+		} else {
+			paths = diffCandidates(sum, st)
+		}
+
+		var d *differ
+		if diffRemote {
+			client, err := newAPIClient()
+			if err != nil {
+				return err
+			}
+			d = &differ{sum: sum, stage: st, remote: func(id string) (*api.GetPostResponse, error) {
+				return api.GetPost(context.Background(), client, id)
+			}}
+		} else {
+			d = &differ{sum: sum, stage: st}
+		}
+
+		var files, added, removed int
+		for _, path := range paths {
+			fd, err := d.file(path)
+			if err != nil {
+				if len(args) == 1 {
+					return err
+				}
+				fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+				continue
+			}
+			if fd == nil {
+				continue
+			}
+			files++
+			if diffStat {
+				a, r := diff.LineStat(fd.oldBody, fd.newBody)
+				added, removed = added+a, removed+r
+				line := fmt.Sprintf(" %s | +%d -%d", path, a, r)
+				if n := len(fd.fields); n > 0 {
+					line += fmt.Sprintf(", %d field(s)", n)
+				}
+				fmt.Println(line)
+				continue
+			}
+			printFileDiff(path, fd)
+		}
+
+		switch {
+		case files == 0:
+			fmt.Println("No differences.")
+		case diffStat:
+			fmt.Printf(" %d file(s) changed, %d insertion(s)(+), %d deletion(s)(-)\n", files, added, removed)
+		}
+		return nil
+	},
+}
+
+// fileDiff holds both sides of one compared file.
+type fileDiff struct {
+	from, to         string // Side labels, e.g. "published" and "working"
+	oldBody, newBody string
+	fields           []diff.FieldChange
+}
+
+// differ loads the two sides of a diff according to the command flags.
+type differ struct {
+	sum    *state.Sum
+	stage  *state.Stage
+	remote func(postID string) (*api.GetPostResponse, error) // Set with --remote
+}
+
+// diffCandidates lists every path hn knows about: ledger articles and pages
+// plus staged markdown, sorted. With --staged only staged paths are listed;
+// with --remote ledger pages are left out since they cannot be fetched.
+func diffCandidates(sum *state.Sum, st *state.Stage) []string {
+	seen := make(map[string]bool)
+	for path, item := range st.Items {
+		if item.Type == "" || item.Type == state.TypeArticle || item.Type == state.TypePage {
+			seen[path] = true
+		}
+	}
+	if !diffStaged {
+		for path := range sum.Articles {
+			seen[path] = true
+		}
+		if !diffRemote {
+			for path := range sum.Pages {
+				seen[path] = true
+			}
+		}
+	}
+	paths := make([]string, 0, len(seen))
+	for path := range seen {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// file compares one path. It returns nil when both sides match.
+func (d *differ) file(path string) (*fileDiff, error) {
+	fd := &fileDiff{to: "working"}
+	var newContent []byte
+	if diffStaged {
+		item, ok := d.stage.Items[path]
+		if !ok {
+			return nil, fmt.Errorf("%s is not staged", path)
+		}
+		fd.to = "staged"
+		if item.Operation != state.OpDelete {
+			content, err := applyutil.LoadRawContentForPath(d.stage, path)
+			if err != nil {
+				return nil, err
+			}
+			newContent = content
+		}
+	} else if content, err := os.ReadFile(applyutil.AbsPath(path)); err == nil {
+		newContent = content
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	newFM, newBody := splitContent(newContent)
+	fd.newBody = newBody
+
+	if d.remote != nil {
+		fd.from = "remote"
+		a, ok := d.sum.Articles[path]
+		if !ok || a.PostID == "" {
+			if _, isPage := d.sum.Pages[path]; isPage {
+				return nil, fmt.Errorf("%s: static pages cannot be read back from the API", path)
+			}
+			fd.fields = diff.FrontmatterChanges(nil, newFM) // Not published yet
+		} else {
+			resp, err := d.remote(a.PostID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fetch post %s for %s: %w", a.PostID, path, err)
+			}
+			if resp == nil || resp.Post == nil {
+				return nil, fmt.Errorf("post %s for %s not found on Hashnode", a.PostID, path)
+			}
+			fd.oldBody = resp.Post.Content.Markdown
+			fd.fields = remoteFieldChanges(resp.Post, newFM)
+		}
+	} else {
+		fd.from = "published"
+		checksum := d.sum.Articles[path].Checksum
+		if checksum == "" {
+			checksum = d.sum.Pages[path].Checksum
+		}
+		if checksum != "" && (newContent == nil || state.ChecksumFromContent(newContent) != checksum) {
+			old, err := state.NewSnapshotStore().GetContentByChecksum(checksum)
+			if err != nil {
+				return nil, fmt.Errorf("%s: last published content is not in the snapshot store (imported?); try --remote", path)
+			}
+			oldFM, oldBody := splitContent(old)
+			fd.oldBody = oldBody
+			fd.fields = diff.FrontmatterChanges(oldFM, newFM)
+		} else if checksum == "" {
+			fd.fields = diff.FrontmatterChanges(nil, newFM)
+		} else {
+			fd.oldBody = newBody // Unchanged since the last publish
+		}
+	}
+
+	if fd.oldBody == fd.newBody && len(fd.fields) == 0 {
+		return nil, nil
+	}
+	return fd, nil
+}
+
+// remoteFields are the frontmatter keys the API returns for a post. Only
+// these are compared with --remote, and only when the local file sets them,
+// since apply leaves unset fields alone.
+var remoteFields = map[string]bool{
+	"title": true, "subtitle": true, "slug": true, "tags": true, "canonical": true,
+	"cover_image_url": true, "series": true, "meta_title": true, "meta_description": true,
+}
+
+func remoteFieldChanges(post *api.GetPostPost, local *state.Frontmatter) []diff.FieldChange {
+	remote := &state.Frontmatter{Title: post.Title, Slug: post.Slug}
+	if post.Subtitle != nil {
+		remote.Subtitle = *post.Subtitle
+	}
+	if post.CanonicalUrl != nil {
+		remote.Canonical = *post.CanonicalUrl
+	}
+	for _, t := range post.Tags {
+		remote.Tags = append(remote.Tags, t.Name)
+	}
+	// Local tags are published by slug, so "Go" and a remote "go" are the same tag.
+	if sameTagSlugs(post.Tags, local.Tags) {
+		remote.Tags = local.Tags
+	}
+	if post.Series != nil {
+		remote.Series = post.Series.Name
+	}
+	if post.CoverImage != nil {
+		remote.CoverImageURL = post.CoverImage.Url
+	}
+	if post.Seo != nil {
+		if post.Seo.Title != nil {
+			remote.MetaTitle = *post.Seo.Title
+		}
+		if post.Seo.Description != nil {
+			remote.MetaDescription = *post.Seo.Description
+		}
+	}
+
+	var changes []diff.FieldChange
+	for _, c := range diff.FrontmatterChanges(remote, local) {
+		if remoteFields[c.Field] && c.New != "" {
+			changes = append(changes, c)
+		}
+	}
+	return changes
+}
+
+// sameTagSlugs reports whether local tags publish as exactly the remote tags.
+func sameTagSlugs(remote []api.GetPostPostTagsTag, local []string) bool {
+	var a, b []string
+	for _, t := range remote {
+		slug := t.Slug
+		if slug == "" {
+			slug = applyutil.TagSlug(t.Name)
+		}
+		a = append(a, slug)
+	}
+	for _, t := range local {
+		if t = strings.TrimSpace(t); t != "" {
+			b = append(b, applyutil.TagSlug(t))
+		}
+	}
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
+
+// splitContent separates frontmatter from the body. Content whose
+// frontmatter does not parse is compared as a whole.
+func splitContent(content []byte) (*state.Frontmatter, string) {
+	fm, body, err := repoCfg.FrontmatterReader().Extract(content)
+	if err != nil {
+		return nil, string(content)
+	}
+	return fm, string(body)
+}
+
+func printFileDiff(path string, fd *fileDiff) {
+	fmt.Printf("diff %s (%s → %s)\n", path, fd.from, fd.to)
+	if len(fd.fields) > 0 {
+		fmt.Println("Frontmatter:")
+		for _, c := range fd.fields {
+			switch {
+			case c.Old == "":
+				fmt.Printf("  + %s: %s\n", c.Field, c.New)
+			case c.New == "":
+				fmt.Printf("  - %s: %s\n", c.Field, c.Old)
+			default:
+				fmt.Printf("  ~ %s: %s → %s\n", c.Field, c.Old, c.New)
+			}
+		}
+	}
+	fmt.Print(diff.Unified("a/"+path, "b/"+path, fd.oldBody, fd.newBody))
+	fmt.Println()
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+	diffCmd.Flags().BoolVar(&diffStaged, "staged", false, "Compare the staged snapshot instead of the working file")
+	diffCmd.Flags().BoolVar(&diffRemote, "remote", false, "Compare against the live post instead of the last published content")
+	diffCmd.Flags().BoolVar(&diffStat, "stat", false, "Show a per-file summary of changed lines and fields")
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+var checkIgnoreCmd = &cobra.Command{
+	Use:   "check-ignore <path>...",
+	Short: "Explain whether paths are excluded from scanning and staging",
+	Long: `Report for each path whether hn treats it as content, and which rule
+decided: a line of a .hnignore file, content.include in hashnode.yml, or the
+built-in rule that skips hidden directories.
+
+.hnignore files use gitignore syntax and apply to their directory and below;
+deeper files and later lines take precedence, and "!pattern" re-includes.
+Files inside an ignored directory cannot be re-included.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ig := state.NewIgnorer()
+		for _, arg := range args {
+			key := state.NormalizePath(arg)
+			info, err := os.Stat(arg)
+			m := ig.Match(key, err == nil && info.IsDir())
+			switch {
+			case m.Ignored:
+				fmt.Printf("%s: ignored by %s\n", key, m.Rule)
+			case m.Rule != nil:
+				fmt.Printf("%s: re-included by %s\n", key, m.Rule)
+			default:
+				fmt.Printf("%s: not ignored\n", key)
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(checkIgnoreCmd)
+}
@@ -3,9 +3,9 @@ package main
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
@@ -17,10 +17,33 @@ import (
 	"adil-adysh/hashnode-cli/internal/state"
 )
 
+var importSince string
+var importSlugs []string
+var importTags []string
+var importSeries []string
+var importIDs []string
+var importIncremental bool
+var importDryRun bool
+
 var importCmd = &cobra.Command{
 	Use:   "import",
-	Short: "Import posts from Hashnode and sync Ledger",
+	Short: "Import posts, drafts and static pages from Hashnode and sync Ledger",
+	Long: `Download the publication's posts, drafts and static pages into the repo
+and link them to their remote IDs in hashnode.sum. Drafts are written with
+'published: false', scheduled drafts with their date as published_at.
+
+--since, --slug, --tag, --series and --ids import only matching posts; they
+list the publication without content and download just the posts they keep.
+--incremental imports only posts updated since the last full or incremental
+import, whose high-water mark is kept in hashnode.sum. --dry-run lists what
+would be written without changing anything.`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		filter, err := newImportFilter()
+		if err != nil {
+			return err
+		}
+
 		// 1. Locking (Global Mutex)
 		release, err := state.AcquireRepoLock()
 		if err != nil {
@@ -33,17 +56,11 @@ var importCmd = &cobra.Command{
 		}()
 
 		// 2. Setup Client
-		cfg, err := config.Load()
+		client, err := newAPIClient()
 		if err != nil {
-			return fmt.Errorf("failed to load home config (run init): %w", err)
-		}
-		if cfg.Token == "" {
-			return fmt.Errorf("no token configured; run 'hashnode init'")
+			return err
 		}
 
-		httpClient := &http.Client{Transport: &authedTransport{token: cfg.Token, wrapped: http.DefaultTransport}}
-		client := graphql.NewClient("https://gql.hashnode.com", httpClient)
-
 		// 3. Load Ledger (The Source of Truth)
 		sum, err := state.LoadSum()
 		if err != nil {
@@ -57,113 +74,147 @@ var importCmd = &cobra.Command{
 			}
 		}
 
-		// 4. API Call (paginated: API max page size is 50)
-		output.Info("Fetching publication data (paginated)...")
-		var allPosts []api.GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdge
-		var seriesEdges []api.GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdge
-		var after *string
-		for {
-			resp, rerr := api.GetPublicationData(context.Background(), client, sum.Blog.PublicationID, 50, after)
-			if rerr != nil {
-				return fmt.Errorf("failed to fetch publication data: %w", rerr)
-			}
-			if resp == nil || resp.Publication == nil {
-				return fmt.Errorf("no publication data returned")
+		// 4. API Call: everything with content, or a listing narrowed down first
+		var fetched importFetch
+		if importIncremental {
+			filter.After = sum.Import.PostsUpdatedAt
+			if filter.After == nil {
+				output.Info("No incremental import mark yet; importing every post")
 			}
-			if seriesEdges == nil {
-				seriesEdges = resp.Publication.SeriesList.Edges
-			}
-			allPosts = append(allPosts, resp.Publication.Posts.Edges...)
-			if resp.Publication.Posts.PageInfo.HasNextPage == nil || !*resp.Publication.Posts.PageInfo.HasNextPage {
-				break
+		}
+		if filter.Selective() || filter.After != nil {
+			fetched, err = fetchSelectedPosts(client, sum.Blog.PublicationID, filter)
+		} else {
+			fetched, err = fetchAllPosts(client, sum.Blog.PublicationID)
+		}
+		if err != nil {
+			return err
+		}
+		// Drafts are few and carry no usable filter fields; fetch them on
+		// runs that are not narrowed down
+		if !filter.Selective() {
+			if fetched.drafts, err = fetchDrafts(client, sum.Blog.PublicationID); err != nil {
+				return err
 			}
-			after = resp.Publication.Posts.PageInfo.EndCursor
 		}
 
 		// 5. Update Ledger Series
-		// We trust the API as the source of truth for Series structure
-		if sum.Series == nil {
-			sum.Series = make(map[string]state.SeriesEntry)
-		}
-		for _, edge := range seriesEdges {
-			n := edge.Node
-			sum.Series[n.Slug] = state.SeriesEntry{
-				SeriesID: n.Id,
-				Name:     n.Name,
-				Slug:     n.Slug,
+		// Remote metadata is merged in; series only known locally are kept
+		for _, n := range fetched.series {
+			entry := state.SeriesEntry{SeriesID: n.Id, Name: n.Name, Slug: n.Slug}
+			if n.Description != nil {
+				entry.Description = n.Description.Markdown
 			}
+			sum.MergeSeries(entry)
 		}
 
 		// 6. Build quick lookups for existing mappings
-		// We need to know if we already have this post mapped to a file
+		// We need to know if we already have this post mapped to a file. A
+		// post published from an imported draft takes over the draft's file.
 		remoteIDToPath := make(map[string]string)
+		draftIDToPath := make(map[string]string)
+		draftSlugToPath := make(map[string]string)
 		for path, entry := range sum.Articles {
 			if entry.PostID != "" {
 				remoteIDToPath[entry.PostID] = path
 			}
+			if entry.DraftID != "" {
+				draftIDToPath[entry.DraftID] = path
+				if entry.PostID == "" {
+					draftSlugToPath[entry.Slug] = path
+				}
+			}
 		}
 
 		// 7. Process Posts (The Core Loop)
-		for _, edge := range allPosts {
-			post := edge.Node
-			content := post.Content.Markdown
-			checksum := state.ChecksumFromContent([]byte(content))
+		var written, unchanged int
+		claimed := make(map[string]bool) // New paths handed out, written or not
+		for _, post := range fetched.posts {
+			content := []byte(post.Content.Markdown)
 
 			// Determine Local Path
-			// A. Check Ledger: Do we already know this post?
-			var outPath string
-			if existingPath, ok := remoteIDToPath[post.Id]; ok {
-				outPath = existingPath
-			} else {
-				// B. New Post: Generate standardized filename
-				published := post.PublishedAt
-				if published.IsZero() {
-					published = time.Now().UTC()
-				}
-				year, month := published.Year(), int(published.Month())
-				outDir := fmt.Sprintf("%04d/%02d", year, month)
-
-				// GenerateFilename ensures no collisions
-				generated, err := state.GenerateFilename(post.Title, outDir)
-				if err != nil {
-					return fmt.Errorf("filename generation failed: %w", err)
+			// A. Check Ledger: Do we already know this post or its draft?
+			outPath, tracked := remoteIDToPath[post.Id]
+			if !tracked {
+				outPath, tracked = draftSlugToPath[post.Slug]
+			}
+			if !tracked {
+				// B. New Post: Path from content.path_template
+				if outPath, err = importNewPath(importPathData(post), claimed); err != nil {
+					return err
 				}
-				outPath = generated
 			}
 
-			// Write to Disk
-			// Ensure dir exists
-			if err := os.MkdirAll(filepath.Dir(filepath.FromSlash(outPath)), 0755); err != nil {
-				return fmt.Errorf("failed to ensure dir: %w", err)
+			changed, err := importWrite(outPath, content)
+			if err != nil {
+				return err
 			}
+			if changed {
+				written++
+			} else {
+				unchanged++
+			}
+			if importDryRun {
+				continue
+			}
+
+			// Update LEDGER (hashnode.sum)
+			// This is the critical step: Linking Path <-> RemoteID and slug
+			sum.SetArticle(outPath, post.Id, state.ChecksumFromContent(content), post.Slug)
+
+			output.Info("Synced: %s", outPath)
+		}
 
-			// Only rewrite if content changed (Optimization)
-			// Check current file on disk if it exists
-			shouldWrite := true
-			if currentBytes, err := os.ReadFile(filepath.FromSlash(outPath)); err == nil {
-				if state.ChecksumFromContent(currentBytes) == checksum {
-					shouldWrite = false
+		// 7a. Drafts and scheduled drafts, kept unpublished in frontmatter.
+		// A draft already published from this repo belongs to its post.
+		for _, draft := range fetched.drafts {
+			outPath, tracked := draftIDToPath[draft.Id]
+			if tracked && sum.Articles[outPath].PostID != "" {
+				continue
+			}
+			content, err := importDraftContent(draft)
+			if err != nil {
+				return fmt.Errorf("draft %s: %w", draft.Slug, err)
+			}
+			if !tracked {
+				if outPath, err = importNewPath(importDraftPathData(draft), claimed); err != nil {
+					return err
 				}
 			}
 
-			if shouldWrite {
-				if err := os.WriteFile(filepath.FromSlash(outPath), []byte(content), 0644); err != nil {
-					return fmt.Errorf("failed to write file %s: %w", outPath, err)
-				}
+			changed, err := importWrite(outPath, content)
+			if err != nil {
+				return err
+			}
+			if changed {
+				written++
+			} else {
+				unchanged++
+			}
+			if importDryRun {
+				continue
 			}
 
-			// Update LEDGER (hashnode.sum)
-			// This is the critical step: Linking Path <-> RemoteID and slug
-			normPath := state.NormalizePath(outPath)
-			sum.SetArticle(normPath, post.Id, checksum, post.Slug)
+			sum.SetDraft(outPath, draft.Id, state.ChecksumFromContent(content), draft.Slug)
+
+			output.Info("Synced draft: %s", outPath)
+		}
 
-			// If post has a series, ensure local mapping knows it (optional, but good for completeness)
-			if post.Series != nil {
-				// You might want to track which article belongs to which series in sum.yml logic
-				// but that's handled by your specific sum schema details.
+		// 7b. Static pages (pages/) are not covered by post filters either
+		if !filter.Selective() {
+			if err := importPages(client, sum, claimed); err != nil {
+				return err
 			}
+		}
 
-			output.Info("Synced: %s", outPath)
+		if importDryRun {
+			fmt.Printf("%d post(s) and draft(s) would be written, %d already up to date\n", written, unchanged)
+			return nil
+		}
+
+		// 7c. A run that saw every post moves the incremental mark
+		if !filter.Selective() {
+			sum.AdvanceImportMark(fetched.latest)
 		}
 
 		// 8. Stage Reconciliation (The "Dumb Stage" Fix)
@@ -186,6 +237,10 @@ var importCmd = &cobra.Command{
 					output.Info("Unstaged %s (matched imported content)", path)
 				}
 			}
+			if pageEntry, ok := sum.Pages[path]; ok && pageEntry.Checksum == item.Checksum {
+				delete(st.Items, path)
+				output.Info("Unstaged %s (matched imported content)", path)
+			}
 		}
 
 		// 9. Persist Ledger (root/hashnode.sum)
@@ -202,3 +257,320 @@ var importCmd = &cobra.Command{
 		return nil
 	},
 }
+
+// newImportFilter builds the filter from the command's flags.
+func newImportFilter() (state.ImportFilter, error) {
+	f := state.ImportFilter{Slugs: importSlugs, Tags: importTags, Series: importSeries, IDs: importIDs}
+	if importSince != "" {
+		t, err := time.ParseInLocation(time.DateOnly, importSince, time.Local)
+		if err != nil {
+			if t, err = time.Parse(time.RFC3339, importSince); err != nil {
+				return f, fmt.Errorf("invalid --since %q (want YYYY-MM-DD or RFC 3339)", importSince)
+			}
+		}
+		f.Since = t
+	}
+	return f, nil
+}
+
+// postChangedAt is when a post last changed remotely.
+func postChangedAt(updatedAt *time.Time, publishedAt time.Time) time.Time {
+	if updatedAt != nil && updatedAt.After(publishedAt) {
+		return *updatedAt
+	}
+	return publishedAt
+}
+
+// importFetch is what import downloaded: the posts and drafts to write, the
+// series list and the latest change among every post listed.
+type importFetch struct {
+	posts  []api.ImportPost
+	drafts []api.ImportDraft
+	series []api.ImportSeries
+	latest time.Time
+}
+
+// fetchAllPosts downloads every post with content (API max page size is 50).
+func fetchAllPosts(client graphql.Client, pubID string) (importFetch, error) {
+	output.Info("Fetching publication data (paginated)...")
+	var f importFetch
+	var after *string
+	for {
+		resp, err := api.GetPublicationData(context.Background(), client, pubID, 50, after, nil)
+		if err != nil {
+			return f, fmt.Errorf("failed to fetch publication data: %w", err)
+		}
+		if resp == nil || resp.Publication == nil {
+			return f, fmt.Errorf("no publication data returned")
+		}
+		if f.series == nil {
+			for _, edge := range resp.Publication.SeriesList.Edges {
+				f.series = append(f.series, edge.Node.ImportSeries)
+			}
+		}
+		for _, edge := range resp.Publication.Posts.Edges {
+			post := edge.Node.ImportPost
+			f.posts = append(f.posts, post)
+			if c := postChangedAt(post.UpdatedAt, post.PublishedAt); c.After(f.latest) {
+				f.latest = c
+			}
+		}
+		info := resp.Publication.Posts.PageInfo
+		if info.HasNextPage == nil || !*info.HasNextPage {
+			return f, nil
+		}
+		after = info.EndCursor
+	}
+}
+
+// fetchSelectedPosts lists the publication's posts without content and
+// downloads only those the filter keeps.
+func fetchSelectedPosts(client graphql.Client, pubID string, filter state.ImportFilter) (importFetch, error) {
+	output.Info("Listing publication posts (paginated)...")
+	var f importFetch
+	var apiFilter *api.PublicationPostConnectionFilter
+	if len(filter.Tags) > 0 {
+		apiFilter = &api.PublicationPostConnectionFilter{TagSlugs: filter.Tags}
+	}
+	var ids []string
+	listed := 0
+	var after *string
+	for {
+		resp, err := api.ListPublicationPosts(context.Background(), client, pubID, 50, after, apiFilter)
+		if err != nil {
+			return f, fmt.Errorf("failed to list publication posts: %w", err)
+		}
+		if resp == nil || resp.Publication == nil {
+			return f, fmt.Errorf("no publication data returned")
+		}
+		if f.series == nil {
+			for _, edge := range resp.Publication.SeriesList.Edges {
+				f.series = append(f.series, edge.Node.ImportSeries)
+			}
+		}
+		for _, edge := range resp.Publication.Posts.Edges {
+			n := edge.Node
+			listed++
+			changed := postChangedAt(n.UpdatedAt, n.PublishedAt)
+			if changed.After(f.latest) {
+				f.latest = changed
+			}
+			var seriesName, seriesSlug string
+			if n.Series != nil {
+				seriesName, seriesSlug = n.Series.Name, n.Series.Slug
+			}
+			if filter.Keep(n.Id, n.Slug, seriesName, seriesSlug, changed) {
+				ids = append(ids, n.Id)
+			}
+		}
+		info := resp.Publication.Posts.PageInfo
+		if info.HasNextPage == nil || !*info.HasNextPage {
+			break
+		}
+		after = info.EndCursor
+	}
+
+	output.Info("Fetching %d of %d post(s)...", len(ids), listed)
+	for _, id := range ids {
+		resp, err := api.GetImportPost(context.Background(), client, id)
+		if err != nil {
+			return f, fmt.Errorf("failed to fetch post %s: %w", id, err)
+		}
+		if resp == nil || resp.Post == nil {
+			return f, fmt.Errorf("post %s was not found", id)
+		}
+		f.posts = append(f.posts, resp.Post.ImportPost)
+	}
+	return f, nil
+}
+
+// fetchDrafts downloads the authenticated user's drafts and scheduled drafts
+// in the publication. A draft in both lists is kept once.
+func fetchDrafts(client graphql.Client, pubID string) ([]api.ImportDraft, error) {
+	output.Info("Fetching drafts (paginated)...")
+	var drafts []api.ImportDraft
+	seen := make(map[string]bool)
+	add := func(d api.ImportDraft) {
+		if !seen[d.Id] {
+			seen[d.Id] = true
+			drafts = append(drafts, d)
+		}
+	}
+
+	var after *string
+	for {
+		resp, err := api.GetPublicationDrafts(context.Background(), client, pubID, 20, after)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch drafts: %w", err)
+		}
+		if resp == nil || resp.Publication == nil {
+			return nil, fmt.Errorf("no publication data returned")
+		}
+		for _, edge := range resp.Publication.Drafts.Edges {
+			add(edge.Node.ImportDraft)
+		}
+		info := resp.Publication.Drafts.PageInfo
+		if info.HasNextPage == nil || !*info.HasNextPage {
+			break
+		}
+		after = info.EndCursor
+	}
+
+	after = nil
+	for {
+		resp, err := api.GetPublicationScheduledDrafts(context.Background(), client, pubID, 20, after)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch scheduled drafts: %w", err)
+		}
+		if resp == nil || resp.Publication == nil {
+			return nil, fmt.Errorf("no publication data returned")
+		}
+		for _, edge := range resp.Publication.ScheduledDrafts.Edges {
+			add(edge.Node.ImportDraft)
+		}
+		info := resp.Publication.ScheduledDrafts.PageInfo
+		if info.HasNextPage == nil || !*info.HasNextPage {
+			return drafts, nil
+		}
+		after = info.EndCursor
+	}
+}
+
+// importNewPath places a post or draft not yet in the ledger with
+// content.path_template (default YYYY/MM/title.md).
+func importNewPath(d config.PathData, claimed map[string]bool) (string, error) {
+	templated, err := repoCfg.Content.PostPath(d)
+	if err != nil {
+		return "", fmt.Errorf("content.path_template for %q: %w", d.Title, err)
+	}
+	return importUniquePath(templated, claimed)
+}
+
+// importUniquePath returns the repo-relative path key, suffixed if needed, so
+// that it is neither an existing file nor claimed earlier in this run, which a
+// dry run has not written. The path is claimed in turn.
+func importUniquePath(key string, claimed map[string]bool) (string, error) {
+	generated, err := state.UniqueUnclaimedPath(importFile(key), claimed)
+	if err != nil {
+		return "", fmt.Errorf("filename generation failed: %w", err)
+	}
+	claimed[generated] = true
+	return state.NormalizePath(generated), nil
+}
+
+// importFile resolves a repo-relative path key against the project root, so
+// import writes the same files from any directory of the repo.
+func importFile(key string) string {
+	return filepath.Join(state.ProjectRootOrCwd(), filepath.FromSlash(key))
+}
+
+// importWrite writes content to the repo-relative outPath unless the file
+// already holds it and reports whether it differed. With --dry-run it only
+// says what it would do.
+func importWrite(outPath string, content []byte) (bool, error) {
+	fsPath := importFile(outPath)
+	current, err := os.ReadFile(fsPath)
+	if err == nil && state.ChecksumFromContent(current) == state.ChecksumFromContent(content) {
+		return false, nil
+	}
+	if importDryRun {
+		if err == nil {
+			fmt.Printf("would update %s\n", outPath)
+		} else {
+			fmt.Printf("would create %s\n", outPath)
+		}
+		return true, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(fsPath), state.DirPerm); err != nil {
+		return false, fmt.Errorf("failed to ensure dir: %w", err)
+	}
+	if err := os.WriteFile(fsPath, content, state.FilePerm); err != nil {
+		return false, fmt.Errorf("failed to write file %s: %w", outPath, err)
+	}
+	return true, nil
+}
+
+// importDraftContent renders a draft as an article whose frontmatter keeps it
+// unpublished: `published: false`, or the scheduled date as published_at.
+func importDraftContent(d api.ImportDraft) ([]byte, error) {
+	fields := []state.FrontmatterField{{Key: "title", Value: draftTitle(d)}, {Key: "slug", Value: d.Slug}}
+	if tags := draftTagSlugs(d); len(tags) > 0 {
+		fields = append(fields, state.FrontmatterField{Key: "tags", Value: tags})
+	}
+	if d.Series != nil {
+		fields = append(fields, state.FrontmatterField{Key: "series", Value: d.Series.Name})
+	}
+	if d.ScheduledDate != nil {
+		fields = append(fields, state.FrontmatterField{Key: "published_at", Value: d.ScheduledDate.UTC()})
+	} else {
+		fields = append(fields, state.FrontmatterField{Key: "published", Value: false})
+	}
+	body := ""
+	if d.Content != nil {
+		body = d.Content.Markdown
+	}
+	return repoCfg.FrontmatterReader().RenderArticle(fields, body)
+}
+
+// draftTitle is the draft's title; drafts may not have one yet.
+func draftTitle(d api.ImportDraft) string {
+	if d.Title != nil && strings.TrimSpace(*d.Title) != "" {
+		return *d.Title
+	}
+	return d.Slug
+}
+
+// draftTagSlugs returns the slugs of a draft's tags, which are either
+// existing tags or ones the draft introduces.
+func draftTagSlugs(d api.ImportDraft) []string {
+	var slugs []string
+	for _, t := range d.TagsV2 {
+		if tag, ok := t.(interface{ GetSlug() string }); ok {
+			slugs = append(slugs, tag.GetSlug())
+		}
+	}
+	return slugs
+}
+
+// importDraftPathData describes a remote draft for content.path_template,
+// dated by its schedule or else by today.
+func importDraftPathData(d api.ImportDraft) config.PathData {
+	date := time.Now().UTC()
+	if d.ScheduledDate != nil {
+		date = *d.ScheduledDate
+	}
+	data := config.NewPathData(draftTitle(d), d.Slug, date)
+	data.ID = d.Id
+	data.Tags = draftTagSlugs(d)
+	if d.Series != nil {
+		data.Series = config.SeriesData{Name: d.Series.Name, Slug: d.Series.Slug}
+	}
+	return data
+}
+
+// importPathData describes a remote post for content.path_template.
+func importPathData(post api.ImportPost) config.PathData {
+	published := post.PublishedAt
+	if published.IsZero() {
+		published = time.Now().UTC()
+	}
+	d := config.NewPathData(post.Title, post.Slug, published)
+	d.ID = post.Id
+	for _, t := range post.Tags {
+		d.Tags = append(d.Tags, t.Slug)
+	}
+	if post.Series != nil {
+		d.Series = config.SeriesData{Name: post.Series.Name, Slug: post.Series.Slug}
+	}
+	return d
+}
+
+func init() {
+	importCmd.Flags().StringVar(&importSince, "since", "", "Only import posts published or updated on or after this date (YYYY-MM-DD or RFC 3339)")
+	importCmd.Flags().StringSliceVar(&importSlugs, "slug", nil, "Only import posts with these slugs")
+	importCmd.Flags().StringSliceVar(&importTags, "tag", nil, "Only import posts with any of these tag slugs")
+	importCmd.Flags().StringSliceVar(&importSeries, "series", nil, "Only import posts in these series (slug or name)")
+	importCmd.Flags().StringSliceVar(&importIDs, "ids", nil, "Only import posts with these remote IDs")
+	importCmd.Flags().BoolVar(&importIncremental, "incremental", false, "Only import posts updated since the last full or incremental import")
+	importCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "List what would be written without changing anything")
+}
@@ -42,12 +42,9 @@ var initCmd = &cobra.Command{
 			token = os.Getenv("HASHNODE_API_KEY")
 		}
 		// 1a. Try loading token from user config in home dir
-		if token == "" {
-			if cfg, err := config.Load(); err == nil {
-				if cfg != nil && cfg.Token != "" {
-					token = cfg.Token
-				}
-			}
+		userCfg, _ := config.Load()
+		if token == "" && userCfg != nil {
+			token = userCfg.Token
 		}
 		if token == "" {
 			fmt.Print("🔑 Enter your Hashnode Personal Access Token: ")
@@ -66,7 +63,12 @@ var initCmd = &cobra.Command{
 				wrapped: http.DefaultTransport,
 			},
 		}
-		client := graphql.NewClient("https://gql.hashnode.com", httpClient)
+		endpoint, err := userCfg.Endpoint()
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			os.Exit(1)
+		}
+		client := graphql.NewClient(endpoint, httpClient)
 
 		// 3. Verify Token via API
 		output.Info("⏳ Verifying token and fetching user details...\n")
@@ -155,6 +157,9 @@ var initCmd = &cobra.Command{
 
 		// 6. Save token to user config (home) for subsequent API calls (non-authoritative)
 		cfg := config.Config{Publications: nil, Token: token}
+		if userCfg != nil {
+			cfg.APIEndpoint = userCfg.APIEndpoint
+		}
 		if err := cfg.Save(); err != nil {
 			output.Error("⚠️  Failed to write home config: %v\n", err)
 		}
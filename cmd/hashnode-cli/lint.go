@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"adil-adysh/hashnode-cli/internal/lint"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+var lintStaged bool
+
+var lintCmd = &cobra.Command{
+	Use:   "lint [path...]",
+	Short: "Validate frontmatter and markdown before publishing",
+	Long: `Check articles for problems Hashnode would reject or that would publish broken content.
+
+Checks include title/subtitle length, tag count and slugs, URL fields,
+publish_as/co_authors IDs, series present in hashnode.sum, unknown frontmatter
+keys, slugs duplicated across files and broken relative links.
+
+'hn stage' and 'hn apply' run the same checks automatically.
+
+Examples:
+  # Lint every markdown file in the repository
+  hn lint
+
+  # Lint a directory or a single file
+  hn lint posts/
+  hn lint posts/go-interfaces.md
+
+  # Lint the staged snapshots that apply would publish
+  hn lint --staged`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var sources []lint.Source
+		var err error
+		if lintStaged {
+			st, serr := state.LoadStage()
+			if serr != nil {
+				return fmt.Errorf("failed to load stage: %w", serr)
+			}
+			sources, err = lint.FromStage(st)
+		} else {
+			if len(args) == 0 {
+				args = []string{state.ProjectRootOrCwd()}
+			}
+			sources, err = lint.Collect(args)
+		}
+		if err != nil {
+			return err
+		}
+
+		diags := runLint(sources)
+		if lint.HasErrors(diags) {
+			return fmt.Errorf("lint failed")
+		}
+		fmt.Printf("✔ %d file(s) checked\n", len(sources))
+		return nil
+	},
+}
+
+// runLint lints sources against the current ledger and stage and prints any diagnostics.
+func runLint(sources []lint.Source) []lint.Diagnostic {
+	sum, _ := state.LoadSum()
+	st, _ := state.LoadStage()
+	diags := lint.Lint(sources, lint.Options{Sum: sum, Stage: st, Rules: repoCfg.Lint, Frontmatter: repoCfg.FrontmatterReader()})
+	for _, d := range diags {
+		symbol := "⚠️ "
+		if d.Severity == lint.SeverityError {
+			symbol = "❌"
+		}
+		fmt.Printf("%s %s\n", symbol, d)
+	}
+	return diags
+}
+
+// lintBeforeStage lints the files about to be staged and fails on errors.
+func lintBeforeStage(paths ...string) error {
+	if stageNoLint {
+		return nil
+	}
+	sources, err := lint.Collect(paths)
+	if err != nil {
+		return err
+	}
+	if lint.HasErrors(runLint(sources)) {
+		return fmt.Errorf("lint failed; fix the errors above or re-run with --no-lint")
+	}
+	return nil
+}
+
+func init() {
+	lintCmd.Flags().BoolVar(&lintStaged, "staged", false, "Lint staged snapshots instead of working files")
+	rootCmd.AddCommand(lintCmd)
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+var logCmd = &cobra.Command{
+	Use:   "log <path>",
+	Short: "List every publish of an article",
+	Long: `List the versions of an article applied to Hashnode, newest first.
+Each version is numbered from 1 (the first publish); pass the number to
+'hn show <path>@<n>' to print that version. Snapshots of the latest
+publishes are kept by gc; older versions are listed without content.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, entry, err := articleHistory(args[0])
+		if err != nil {
+			return err
+		}
+		if len(entry.History) == 0 {
+			fmt.Printf("%s has no recorded publishes\n", path)
+			return nil
+		}
+		store := state.NewSnapshotStore()
+		for n := len(entry.History); n >= 1; n-- {
+			h := entry.History[n-1]
+			line := fmt.Sprintf("#%-3d %s  %s", n, h.AppliedAt.Local().Format("2006-01-02 15:04"), shortChecksum(h.Checksum))
+			if h.RemoteUpdatedAt != nil {
+				line += "  remote " + h.RemoteUpdatedAt.Local().Format("2006-01-02 15:04:05")
+			}
+			if !store.Exists(h.Checksum + ".md") {
+				line += "  (content pruned)"
+			}
+			if h.Rollback {
+				line += "  (rollback" + rollbackSource(entry.History[:n-1], h.Checksum) + ")"
+			}
+			if n == len(entry.History) && h.Checksum == entry.Checksum {
+				line += "  (current)"
+			}
+			fmt.Println(line)
+		}
+		return nil
+	},
+}
+
+var showCmd = &cobra.Command{
+	Use:   "show <path>[@<n>|@<checksum>]",
+	Short: "Print a published version of an article",
+	Long: `Print version n of an article as it was applied (see 'hn log'). A
+checksum prefix of at least 6 characters may be given instead of n; a ref
+that could be either is read as a checksum, so write #n to force a version.
+Without @ the latest published version is printed.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		arg, ref := args[0], ""
+		if at := strings.LastIndex(arg, "@"); at > 0 {
+			arg, ref = arg[:at], arg[at+1:]
+		}
+		path, entry, err := articleHistory(arg)
+		if err != nil {
+			return err
+		}
+		if len(entry.History) == 0 {
+			return fmt.Errorf("%s has no recorded publishes", path)
+		}
+		n := len(entry.History)
+		if ref != "" {
+			if n, err = entry.FindVersion(ref); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+		}
+		content, err := state.NewSnapshotStore().GetContentByChecksum(entry.History[n-1].Checksum)
+		if err != nil {
+			return fmt.Errorf("version %d of %s is no longer kept (gc retains the latest %d)", n, path, state.HistoryRetention)
+		}
+		_, err = os.Stdout.Write(content)
+		return err
+	},
+}
+
+// articleHistory loads the ledger entry of the article at p.
+func articleHistory(p string) (string, state.ArticleSum, error) {
+	sum, err := state.LoadSum()
+	if err != nil {
+		return "", state.ArticleSum{}, fmt.Errorf("failed to load hashnode.sum: %w", err)
+	}
+	path := state.NormalizePath(p)
+	entry, ok := sum.Articles[path]
+	if !ok {
+		return "", state.ArticleSum{}, fmt.Errorf("%s is not tracked in hashnode.sum", path)
+	}
+	return path, entry, nil
+}
+
+// rollbackSource names the earlier version a rollback restored, if any.
+func rollbackSource(earlier []state.PublishRecord, checksum string) string {
+	for n := len(earlier); n >= 1; n-- {
+		if earlier[n-1].Checksum == checksum {
+			return fmt.Sprintf(" to #%d", n)
+		}
+	}
+	return ""
+}
+
+func shortChecksum(c string) string {
+	if len(c) > 12 {
+		return c[:12]
+	}
+	return c
+}
+
+func init() {
+	rootCmd.AddCommand(logCmd)
+	rootCmd.AddCommand(showCmd)
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+var mvCmd = &cobra.Command{
+	Use:   "mv <old> <new>",
+	Short: "Move an article and keep its remote post",
+	Long: `Move (rename) a markdown file and re-key its entry in hashnode.sum and the
+stage, so the remote post ID follows the file. A staged change moves with the
+file; otherwise the new path is staged. If the content is unchanged the plan
+shows no remote change.
+
+If the file was already moved (e.g. with 'git mv'), only the ledger and stage
+are updated. On failure the file and ledger are restored.`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		release, err := state.AcquireRepoLock()
+		if err != nil {
+			return fmt.Errorf("failed to acquire repo lock: %w", err)
+		}
+		defer func() {
+			if err := release(); err != nil {
+				fmt.Printf("warning: failed to remove lock: %v\n", err)
+			}
+		}()
+
+		res, err := state.MovePath(args[0], args[1])
+		if err != nil {
+			return err
+		}
+
+		verb := "Moved"
+		if !res.FileMoved {
+			verb = "Recorded move"
+		}
+		if res.PostID != "" {
+			fmt.Printf("✔ %s %s → %s (post %s kept)\n", verb, res.From, res.To, res.PostID)
+		} else {
+			fmt.Printf("✔ %s %s → %s\n", verb, res.From, res.To)
+		}
+		fmt.Println("Next: hashnode plan")
+		return nil
+	},
+}
+
+var rmCached bool
+var rmForce bool
+
+var rmCmd = &cobra.Command{
+	Use:   "rm <path>",
+	Short: "Delete an article locally and stage its remote deletion",
+	Long: `Stage the deletion of every tracked article at or below <path> and remove
+the files from the working tree. The path may already be gone from disk; it is
+matched against hashnode.sum. Files that were staged but never published are
+unstaged instead.
+
+Like 'git rm', it refuses to remove a file that was never published or whose
+content matches neither hashnode.sum nor the stage, since nothing could
+restore it. --force removes such files anyway.
+
+Use --cached to keep the local files.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		release, err := state.AcquireRepoLock()
+		if err != nil {
+			return fmt.Errorf("failed to acquire repo lock: %w", err)
+		}
+		defer func() {
+			if err := release(); err != nil {
+				fmt.Printf("warning: failed to remove lock: %v\n", err)
+			}
+		}()
+
+		removed, err := state.RemovePaths(args[0], rmCached, rmForce)
+		for _, p := range removed {
+			fmt.Printf("rm %s\n", p)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✔ %d article(s) staged for deletion\n", len(removed))
+		fmt.Println("Next: hashnode plan | hashnode apply --yes")
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(mvCmd)
+	rootCmd.AddCommand(rmCmd)
+	rmCmd.Flags().BoolVar(&rmCached, "cached", false, "Keep the local files; only stage the deletion")
+	rmCmd.Flags().BoolVarP(&rmForce, "force", "f", false, "Remove files with changes that were never applied")
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"adil-adysh/hashnode-cli/internal/config"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+var newSlug string
+var newSeries string
+var newTags []string
+var newPath string
+var newStage bool
+
+var newCmd = &cobra.Command{
+	Use:   "new <title>",
+	Short: "Create an article file where content.path_template puts it",
+	Long: `Create a markdown file with frontmatter for a new article. The path comes
+from content.path_template in hashnode.yml (default YYYY/MM/<title>.md), so
+new posts land where imported ones do; --path overrides it. A template ending
+in /index.md creates a page bundle, one directory per post.
+
+The file is not published until it is staged and applied; pass --stage to
+stage it right away.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		title := strings.TrimSpace(args[0])
+		if title == "" {
+			return fmt.Errorf("title must not be empty")
+		}
+		data := config.NewPathData(title, newSlug, time.Now())
+		data.Tags = newTags
+		if newSeries != "" {
+			data.Series = config.SeriesData{Name: newSeries, Slug: state.SeriesSlug(newSeries)}
+			if sum, err := state.LoadSum(); err == nil {
+				if se, ok := sum.FindSeries(newSeries); ok {
+					data.Series = config.SeriesData{Name: se.Name, Slug: se.Slug}
+				}
+			}
+		}
+
+		target := newPath
+		if target == "" {
+			p, err := repoCfg.Content.PostPath(data)
+			if err != nil {
+				return fmt.Errorf("content.path_template: %w", err)
+			}
+			if target, err = state.UniquePath(filepath.Join(state.ProjectRootOrCwd(), filepath.FromSlash(p))); err != nil {
+				return err
+			}
+		} else if _, err := os.Stat(target); err == nil {
+			return fmt.Errorf("%s already exists", target)
+		}
+
+		fields := []state.FrontmatterField{{Key: "title", Value: title}}
+		if newSlug != "" {
+			fields = append(fields, state.FrontmatterField{Key: "slug", Value: newSlug})
+		}
+		if len(newTags) > 0 {
+			fields = append(fields, state.FrontmatterField{Key: "tags", Value: newTags})
+		}
+		if newSeries != "" {
+			fields = append(fields, state.FrontmatterField{Key: "series", Value: data.Series.Name})
+		}
+		content, err := repoCfg.FrontmatterReader().RenderArticle(fields, "")
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(target), state.DirPerm); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", target, err)
+		}
+		if err := state.AtomicWriteFile(target, content, state.FilePerm); err != nil {
+			return fmt.Errorf("failed to write %s: %w", target, err)
+		}
+		key := state.NormalizePath(target)
+		fmt.Printf("✔ Created %s\n", key)
+
+		if newStage {
+			if err := state.StageAdd(target); err != nil {
+				return err
+			}
+			fmt.Printf("✔ Staged %s\n", key)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(newCmd)
+	newCmd.Flags().StringVar(&newSlug, "slug", "", "Slug to publish under (default: derived from the title)")
+	newCmd.Flags().StringVar(&newSeries, "series", "", "Series the article belongs to")
+	newCmd.Flags().StringSliceVar(&newTags, "tags", nil, "Comma-separated tags")
+	newCmd.Flags().StringVar(&newPath, "path", "", "Write to this path instead of the path template")
+	newCmd.Flags().BoolVar(&newStage, "stage", false, "Stage the new file")
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/Khan/genqlient/graphql"
+
+	"adil-adysh/hashnode-cli/internal/api"
+	"adil-adysh/hashnode-cli/internal/cli/output"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// importPages writes the publication's static pages to pages/<slug>.md and
+// records them in sum.Pages. Pages already tracked keep their path; a new page
+// never overwrites a local file (see importUniquePath). Hidden pages are
+// imported too; visibility is not represented in frontmatter. With --dry-run,
+// pages that would be written are listed instead.
+func importPages(client graphql.Client, sum *state.Sum, claimed map[string]bool) error {
+	if sum.Pages == nil {
+		sum.Pages = make(map[string]state.PageSum)
+	}
+	idToPath := make(map[string]string)
+	for p, entry := range sum.Pages {
+		idToPath[entry.PageID] = p
+	}
+
+	var after *string
+	for {
+		resp, err := api.GetStaticPages(context.Background(), client, sum.Blog.PublicationID, 20, after)
+		if err != nil {
+			return fmt.Errorf("failed to fetch static pages: %w", err)
+		}
+		if resp == nil || resp.Publication == nil {
+			return fmt.Errorf("no publication data returned")
+		}
+		for _, edge := range resp.Publication.StaticPages.Edges {
+			page := edge.Node
+			outPath, ok := idToPath[page.Id]
+			if !ok {
+				if outPath, err = importUniquePath(path.Join(state.PagesDir, page.Slug+".md"), claimed); err != nil {
+					return err
+				}
+			}
+
+			// Pages have no frontmatter remotely; title and slug are added here
+			ed := state.NewFrontmatterEditor([]byte(page.Content.Markdown))
+			if err := ed.Set("title", page.Title); err != nil {
+				return fmt.Errorf("page %s: %w", page.Slug, err)
+			}
+			if err := ed.Set("slug", page.Slug); err != nil {
+				return fmt.Errorf("page %s: %w", page.Slug, err)
+			}
+			content := ed.Bytes()
+
+			if _, err := importWrite(outPath, content); err != nil {
+				return err
+			}
+			if importDryRun {
+				continue
+			}
+
+			sum.Pages[outPath] = state.PageSum{PageID: page.Id, Checksum: state.ChecksumFromContent(content), Slug: page.Slug, Title: page.Title}
+			output.Info("Synced page: %s", outPath)
+		}
+		info := resp.Publication.StaticPages.PageInfo
+		if info.HasNextPage == nil || !*info.HasNextPage {
+			return nil
+		}
+		after = info.EndCursor
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"sort"
 
 	"adil-adysh/hashnode-cli/internal/diff"
+	"adil-adysh/hashnode-cli/internal/provider"
 	"adil-adysh/hashnode-cli/internal/state"
 
 	"github.com/spf13/cobra"
@@ -59,6 +60,7 @@ var planCmd = &cobra.Command{
 						RemotePostID: sa.PostID,
 						Checksum:     sa.Checksum,
 						Title:        sa.Title,
+						Slug:         sa.Slug,
 					}
 					merged = append(merged, entry)
 					delete(regMap, path)
@@ -94,8 +96,19 @@ var planCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		// Plan used by apply: computed from Stage + Ledger
-		stagedPlan := diff.GeneratePlan(merged, st)
+		// Plan used by apply: every provider diffs Stage + Ledger
+		env := &provider.Env{Stage: st, Repo: repoCfg}
+		if sumErr == nil && sum.ValidateAgainstBlog() == nil {
+			env.Sum = sum
+		}
+		// Remote reads (redirects.yml) need the API; without a token they are reported below
+		if client, cerr := newAPIClient(); cerr == nil {
+			env.Client = client
+		}
+		stagedPlan, perr := provider.BuildPlan(env)
+		if perr != nil {
+			fmt.Printf("⚠️  incomplete plan: %v\n", perr)
+		}
 
 		var stagedItems []diff.PlanItem
 		var excludedItems []diff.PlanItem
@@ -113,7 +126,7 @@ var planCmd = &cobra.Command{
 					it.Title = meta.Title
 				}
 			}
-			if si, ok := st.Items[it.Path]; ok {
+			if si, ok := st.Items[it.Path]; ok && it.OldPath == "" && it.Kind == "" && it.Type != diff.ActionSkip {
 				it.Reason = string(si.Operation)
 			}
 			stagedItems = append(stagedItems, it)
@@ -195,9 +208,11 @@ var planCmd = &cobra.Command{
 		}
 
 		// Build grouped lists
-		var delItems, createItems, updateItemsList []diff.PlanItem
+		var delItems, createItems, updateItemsList, skipItems []diff.PlanItem
 		for _, it := range stagedItems {
 			switch it.Type {
+			case diff.ActionSkip:
+				skipItems = append(skipItems, it)
 			case diff.ActionDelete:
 				delItems = append(delItems, it)
 			case diff.ActionCreate:
@@ -216,11 +231,17 @@ var planCmd = &cobra.Command{
 		fmt.Printf("   🔴  Deletes: %d\n", len(delItems))
 		fmt.Printf("   🟢  Creates: %d\n", len(createItems))
 		fmt.Printf("   🟡  Updates: %d\n", len(updateItemsList))
+		if len(skipItems) > 0 {
+			fmt.Printf("   ⚪  Skipped: %d\n", len(skipItems))
+		}
 		fmt.Println("---------------------------------------------------")
 		fmt.Println()
 
 		// helper to choose reason text
 		reasonFor := func(it diff.PlanItem) string {
+			if it.OldPath != "" || it.Kind != "" {
+				return it.Reason
+			}
 			if si, ok := st.Items[it.Path]; ok {
 				if si.Operation == state.OpDelete {
 					return "Marked for removal in stage"
@@ -275,11 +296,38 @@ var planCmd = &cobra.Command{
 				if title == "" {
 					title = state.NormalizePath(it.Path)
 				}
-				fmt.Printf("   %s (%s)\n", title, it.Path)
+				if it.OldPath != "" {
+					fmt.Printf("   %s (%s → %s)\n", title, it.OldPath, it.Path)
+				} else {
+					fmt.Printf("   %s (%s)\n", title, it.Path)
+				}
+				if it.OldSlug != "" {
+					fmt.Printf("     ├─ Slug change: %s → %s (old URL breaks unless redirected; see apply --redirect-slugs)\n", it.OldSlug, it.NewSlug)
+				}
 				fmt.Printf("     └─ Reason: %s\n\n", reasonFor(it))
 			}
 		}
 
+		// Skipped (staged but not applied, e.g. drafts kept unpublished)
+		if len(skipItems) > 0 {
+			fmt.Println("⚪  SKIPPED")
+			for _, it := range skipItems {
+				title := it.Title
+				if title == "" {
+					title = state.NormalizePath(it.Path)
+				}
+				fmt.Printf("   %s (%s)\n", title, it.Path)
+				fmt.Printf("     └─ Reason: %s\n\n", it.Reason)
+			}
+		}
+
+		for _, it := range stagedPlan {
+			if it.Kind == state.TypePage && it.Type != diff.ActionSkip {
+				fmt.Println("⚠️  Static pages cannot be applied yet: the Hashnode API has no page mutations.")
+				fmt.Println("   They stay staged after apply; edit them in the dashboard meanwhile.")
+				break
+			}
+		}
 		fmt.Println("---------------------------------------------------")
 		fmt.Println("Run 'hashnode apply' to execute these changes.")
 	},
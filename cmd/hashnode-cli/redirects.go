@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"adil-adysh/hashnode-cli/internal/provider"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+var redirectsForce bool
+
+var redirectsCmd = &cobra.Command{
+	Use:   "redirects",
+	Short: "Manage publication redirection rules",
+	Long: `Redirection rules are declared in redirects.yml at the repo root:
+
+  redirects:
+    - source: /old-post
+      destination: /new-post
+      type: permanent   # or temporary
+
+Stage the file like an article; 'hashnode plan' diffs it against the rules on
+Hashnode and 'hashnode apply' creates, updates and deletes rules to match.
+Deleting rules requires --yes. Rules created by 'apply --redirect-slugs' are
+tracked in hashnode.sum and are not managed through redirects.yml.`,
+}
+
+var redirectsPullCmd = &cobra.Command{
+	Use:   "pull",
+	Short: "Write redirects.yml from the rules on Hashnode",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := state.RedirectsPath()
+		if _, err := os.Stat(path); err == nil && !redirectsForce {
+			return fmt.Errorf("%s already exists; use --force to overwrite", state.RedirectsFile)
+		}
+		s, err := state.LoadSum()
+		if err != nil {
+			return fmt.Errorf("failed to load hashnode.sum: %w", err)
+		}
+		client, err := newAPIClient()
+		if err != nil {
+			return err
+		}
+		remote, err := provider.FetchRedirects(&provider.Env{Client: client, Sum: s})
+		if err != nil {
+			return err
+		}
+
+		doc := state.RedirectsDoc{Redirects: []state.Redirect{}}
+		for _, r := range remote {
+			doc.Redirects = append(doc.Redirects, state.Redirect{Source: r.Source, Destination: r.Destination, Type: r.Type})
+		}
+		out, err := yaml.Marshal(doc)
+		if err != nil {
+			return err
+		}
+		if err := state.AtomicWriteFile(path, out, state.FilePerm); err != nil {
+			return fmt.Errorf("failed to write %s: %w", state.RedirectsFile, err)
+		}
+		fmt.Printf("✔ Wrote %d rule(s) to %s\n", len(doc.Redirects), state.RedirectsFile)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(redirectsCmd)
+	redirectsCmd.AddCommand(redirectsPullCmd)
+	redirectsPullCmd.Flags().BoolVarP(&redirectsForce, "force", "f", false, "Overwrite an existing redirects.yml")
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+var restoreSource string
+var restoreDryRun bool
+var restoreYes bool
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore <path>...",
+	Short: "Overwrite working files with their staged or last published content",
+	Long: `Overwrite each working file with its staged snapshot, or with the content
+last published from this repo when given --source=ledger. The stage and
+ledger are not changed.
+
+Overwriting a file with unstaged edits asks for confirmation; pass --yes to
+skip it or --dry-run to only list what would change.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if restoreSource != state.RestoreFromStage && restoreSource != state.RestoreFromLedger {
+			return fmt.Errorf("invalid --source %q (want stage or ledger)", restoreSource)
+		}
+		release, err := state.AcquireRepoLock()
+		if err != nil {
+			return fmt.Errorf("failed to acquire repo lock: %w", err)
+		}
+		defer func() {
+			if err := release(); err != nil {
+				fmt.Printf("warning: failed to remove lock: %v\n", err)
+			}
+		}()
+
+		todo, upToDate, err := state.PlanRestore(args, restoreSource)
+		if err != nil {
+			return err
+		}
+		for _, p := range upToDate {
+			fmt.Printf("%s already matches the %s version\n", p, restoreSource)
+		}
+
+		if restoreDryRun {
+			for _, r := range todo {
+				note := ""
+				if r.Overwrite {
+					note = " (discards working changes)"
+				}
+				fmt.Printf("would restore %s from %s%s\n", r.Path, restoreSource, note)
+			}
+			return nil
+		}
+		if overwrites := state.RestoreOverwrites(todo); len(overwrites) > 0 && !restoreYes {
+			if !confirm(fmt.Sprintf("Discard working changes in %s?", strings.Join(overwrites, ", "))) {
+				return fmt.Errorf("restore cancelled")
+			}
+		}
+
+		if err := state.ApplyRestore(todo); err != nil {
+			return err
+		}
+		for _, r := range todo {
+			fmt.Printf("✔ Restored %s from %s\n", r.Path, restoreSource)
+		}
+		return nil
+	},
+}
+
+var resetKeepSnapshots bool
+var resetDryRun bool
+var resetYes bool
+
+var resetCmd = &cobra.Command{
+	Use:   "reset",
+	Short: "Clear the stage and remove unreferenced snapshots",
+	Long: `Unstage everything and garbage-collect the snapshots that only the stage
+referenced. Working files are not touched; staged content that differs from
+them is deleted with its snapshot unless --keep-snapshots is given.
+
+Asks for confirmation unless --yes is given; --dry-run lists what would be
+unstaged.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		release, err := state.AcquireRepoLock()
+		if err != nil {
+			return fmt.Errorf("failed to acquire repo lock: %w", err)
+		}
+		defer func() {
+			if err := release(); err != nil {
+				fmt.Printf("warning: failed to remove lock: %v\n", err)
+			}
+		}()
+
+		st, err := state.LoadStage()
+		if err != nil {
+			return fmt.Errorf("failed to load stage: %w", err)
+		}
+		paths := st.Paths()
+		if len(paths) == 0 {
+			fmt.Println("Stage is already empty.")
+			return nil
+		}
+
+		if resetDryRun {
+			for _, p := range paths {
+				fmt.Printf("would unstage %s\n", p)
+			}
+			if !resetKeepSnapshots {
+				fmt.Println("would remove snapshots no longer referenced")
+			}
+			return nil
+		}
+		if !resetYes && !confirm(fmt.Sprintf("Unstage %d item(s)?", len(paths))) {
+			return fmt.Errorf("reset cancelled")
+		}
+
+		unstaged, stats, err := state.ResetStage(resetKeepSnapshots)
+		if len(unstaged) > 0 {
+			fmt.Printf("✔ Unstaged %d item(s)\n", len(unstaged))
+		}
+		if err != nil {
+			return err
+		}
+		if stats != nil && stats.RemovedCount > 0 {
+			fmt.Printf("🧹 Removed %d old snapshot(s)\n", stats.RemovedCount)
+		}
+		return nil
+	},
+}
+
+// confirm asks a yes/no question on stdin; anything but y or yes is no.
+func confirm(question string) bool {
+	fmt.Printf("%s [y/N]: ", question)
+	answer, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+func init() {
+	rootCmd.AddCommand(restoreCmd)
+	rootCmd.AddCommand(resetCmd)
+	restoreCmd.Flags().StringVar(&restoreSource, "source", "stage", "Content to restore: stage or ledger")
+	restoreCmd.Flags().BoolVar(&restoreDryRun, "dry-run", false, "List what would be restored without writing files")
+	restoreCmd.Flags().BoolVarP(&restoreYes, "yes", "y", false, "Overwrite working changes without asking")
+	resetCmd.Flags().BoolVar(&resetKeepSnapshots, "keep-snapshots", false, "Do not garbage-collect snapshots after clearing the stage")
+	resetCmd.Flags().BoolVar(&resetDryRun, "dry-run", false, "List what would be unstaged without changing anything")
+	resetCmd.Flags().BoolVarP(&resetYes, "yes", "y", false, "Clear the stage without asking")
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"adil-adysh/hashnode-cli/internal/applyutil"
+	"adil-adysh/hashnode-cli/internal/diff"
+	"adil-adysh/hashnode-cli/internal/provider"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+var rollbackTo string
+var rollbackApply bool
+var rollbackForce bool
+
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback <path>",
+	Short: "Restore an earlier published version of an article and stage it",
+	Long: `Overwrite an article with an earlier published version (see 'hn log') and
+stage it. By default the version published before the current one is
+restored; --to selects a version number or checksum prefix.
+
+With --apply the restored version is published straight away and recorded
+in the article's history as a rollback; other staged changes are left staged.
+Unpublished or staged edits are only overwritten with --force.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		release, err := state.AcquireRepoLock()
+		if err != nil {
+			return fmt.Errorf("failed to acquire repo lock: %w", err)
+		}
+		defer func() {
+			if err := release(); err != nil {
+				fmt.Printf("warning: failed to remove lock: %v\n", err)
+			}
+		}()
+
+		path, entry, err := articleHistory(args[0])
+		if err != nil {
+			return err
+		}
+		n, err := rollbackVersion(entry)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		target := entry.History[n-1]
+		content, err := state.NewSnapshotStore().GetContentByChecksum(target.Checksum)
+		if err != nil {
+			return fmt.Errorf("version %d of %s is no longer kept (gc retains the latest %d)", n, path, state.HistoryRetention)
+		}
+
+		st, err := state.LoadStage()
+		if err != nil {
+			return fmt.Errorf("failed to load stage: %w", err)
+		}
+		if !rollbackForce {
+			if err := checkUnpublishedEdits(path, entry, st); err != nil {
+				return err
+			}
+		}
+
+		if err := state.AtomicWriteFile(applyutil.AbsPath(path), content, state.FilePerm); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		if err := state.StageAdd(path); err != nil {
+			return err
+		}
+		fmt.Printf("✔ Restored %s to version #%d (%s) and staged it\n", path, n, shortChecksum(target.Checksum))
+		if !rollbackApply {
+			fmt.Println("Next: hashnode plan | hashnode apply (or re-run with --apply)")
+			return nil
+		}
+		return applyRollback(path)
+	},
+}
+
+// rollbackVersion picks the version to restore: --to, else the latest
+// publish whose content differs from the current one.
+func rollbackVersion(entry state.ArticleSum) (int, error) {
+	if rollbackTo != "" {
+		return entry.FindVersion(rollbackTo)
+	}
+	for n := len(entry.History); n >= 1; n-- {
+		if entry.History[n-1].Checksum != entry.Checksum {
+			return n, nil
+		}
+	}
+	return 0, fmt.Errorf("no earlier published version to roll back to")
+}
+
+// checkUnpublishedEdits refuses to overwrite working or staged content that
+// differs from the published version.
+func checkUnpublishedEdits(path string, entry state.ArticleSum, st *state.Stage) error {
+	if item, ok := st.Items[path]; ok && item.Checksum != entry.Checksum {
+		return fmt.Errorf("%s has staged changes that rollback would replace; re-run with --force", path)
+	}
+	current, err := os.ReadFile(applyutil.AbsPath(path))
+	if err != nil {
+		return nil // Missing file: nothing to lose
+	}
+	if state.ChecksumFromContent(current) != entry.Checksum {
+		return fmt.Errorf("%s has unpublished edits that rollback would overwrite; re-run with --force", path)
+	}
+	return nil
+}
+
+// applyRollback publishes the staged rollback of path alone and records it.
+func applyRollback(path string) error {
+	client, err := newAPIClient()
+	if err != nil {
+		return err
+	}
+	sum, err := state.LoadSum()
+	if err != nil {
+		return fmt.Errorf("failed to load hashnode.sum: %w", err)
+	}
+	st, err := state.LoadStage()
+	if err != nil {
+		return fmt.Errorf("failed to load stage: %w", err)
+	}
+	env := &provider.Env{Ctx: context.Background(), Client: client, Sum: sum, Stage: st, Repo: repoCfg}
+	all, err := provider.For(state.TypeArticle).Diff(env)
+	if err != nil {
+		return err
+	}
+	var plan []diff.PlanItem
+	for _, it := range all {
+		if it.Path == path {
+			plan = append(plan, it)
+		}
+	}
+	if len(plan) == 0 || plan[0].Type != diff.ActionUpdate {
+		return fmt.Errorf("rollback of %s did not plan an update; it stays staged", path)
+	}
+
+	_, applyErr := provider.Apply(env, plan)
+	if applyErr == nil {
+		if entry := sum.Articles[path]; len(entry.History) > 0 {
+			entry.History[len(entry.History)-1].Rollback = true
+			sum.Articles[path] = entry
+		}
+	}
+	if err := state.SaveSum(sum); err != nil {
+		return fmt.Errorf("failed to save hashnode.sum: %w", err)
+	}
+	if applyErr != nil {
+		return applyErr
+	}
+	delete(st.Items, path)
+	if err := state.SaveStage(st); err != nil {
+		return fmt.Errorf("failed to update stage: %w", err)
+	}
+	if stats, gerr := state.NewSnapshotStore().GC(false); gerr == nil && stats.RemovedCount > 0 {
+		fmt.Printf("🧹 Removed %d old snapshot(s)\n", stats.RemovedCount)
+	}
+	fmt.Printf("✔ Rolled back %s on Hashnode\n", path)
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(rollbackCmd)
+	rollbackCmd.Flags().StringVar(&rollbackTo, "to", "", "Version number (n or #n) or checksum prefix to restore (default: the previous version)")
+	rollbackCmd.Flags().BoolVar(&rollbackApply, "apply", false, "Publish the restored version immediately")
+	rollbackCmd.Flags().BoolVarP(&rollbackForce, "force", "f", false, "Overwrite unpublished or staged edits")
+}
@@ -2,14 +2,28 @@ package main
 
 import (
 	"github.com/spf13/cobra"
+
+	"adil-adysh/hashnode-cli/internal/config"
 )
 
 var rootCmd = &cobra.Command{
 	Use:   "hn",
 	Short: "hn - Hashnode Git Sync",
 	Long:  "hn is a CLI to manage Hashnode blogs from a git repo.",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// Load committed repo conventions (hashnode.yml) before any command runs
+		cfg, err := config.LoadRepo()
+		if err != nil {
+			return err
+		}
+		repoCfg = cfg
+		return repoCfg.Activate()
+	},
 }
 
+// repoCfg holds the settings loaded from hashnode.yml for the running command.
+var repoCfg = &config.RepoConfig{}
+
 // Execute runs the root command.
 func Execute() error {
 	return rootCmd.Execute()
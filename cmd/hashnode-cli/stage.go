@@ -12,125 +12,105 @@ import (
 )
 
 var stageCmd = &cobra.Command{
-	Use:   "stage",
+	Use:   "stage [path|glob]...",
 	Short: "Manage staging area (select what will be applied)",
-	Args:  cobra.MaximumNArgs(1),
+	Long: `Stage files, directories or glob patterns such as 'posts/**/go-*.md'
+(quote them so the shell leaves ** alone). 'hn stage <path>...' is short for
+'hn stage add <path>...'.
+
+  --changed            stage only files that differ from hashnode.sum
+  -A, --all            stage every changed file in the repo (implies --changed)
+  --include-untracked  with --changed, also stage files not yet published`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		// top-level convenience: `hn stage <path>` behaves like `hn stage add <path>`
-		if len(args) == 0 {
+		if len(args) == 0 && !stageAll {
 			return cmd.Usage()
 		}
-		p := args[0]
-		info, err := os.Stat(p)
-		if err == nil && info.IsDir() {
-			fmt.Printf("➕ Staging tracked articles under %s\n\n", p)
-			staged, skipped, err := state.StageDir(p)
-			if err != nil {
-				return err
-			}
-			fmt.Printf("✔ %d articles staged\n", len(staged))
-			fmt.Printf("ℹ️  %d files ignored (not Hashnode articles)\n\n", len(skipped))
-			fmt.Println("Next:")
-			fmt.Println("  • Review staged changes: hashnode stage list")
-			fmt.Println("  • Preview publish plan: hashnode plan")
-			if stageAddVerbose {
-				if len(staged) > 0 {
-					fmt.Println("Staged:")
-					for _, s := range staged {
-						fmt.Printf("  - %s\n", s)
-					}
-				}
-				if len(skipped) > 0 {
-					fmt.Println("Ignored:")
-					for _, s := range skipped {
-						fmt.Printf("  - %s\n", s)
-					}
-				}
-			}
-			return nil
-		}
-		if err := state.StageAdd(p); err != nil {
-			return err
-		}
-		fmt.Printf("✔ 1 article staged (%s)\n", state.NormalizePath(p))
-
-		// Clean up any old snapshot that was replaced
-		snapStore := state.NewSnapshotStore()
-		if stats, cerr := snapStore.GC(false); cerr == nil && stats.RemovedCount > 0 {
-			fmt.Printf("🧹 Removed %d old snapshot(s)\n", stats.RemovedCount)
-		}
-
-		fmt.Println("Next: hashnode stage list | hashnode plan")
-		return nil
+		return runStage(args)
 	},
 }
 
 var stageAddCmd = &cobra.Command{
-	Use:   "add <path>",
-	Short: "Add a tracked file to the staging include list",
-	Args:  cobra.ExactArgs(1),
+	Use:   "add <path|glob>...",
+	Short: "Add files, directories or glob matches to the stage",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		p := args[0]
-		info, err := os.Stat(p)
-		if err != nil {
-			return fmt.Errorf("path does not exist: %s", p)
-		}
-
-		// directory: stage all tracked files under it
-		if info.IsDir() {
-			fmt.Printf("➕ Staging tracked articles under %s\n\n", p)
-			staged, skipped, err := state.StageDir(p)
-			if err != nil {
-				return err
-			}
-			fmt.Printf("✔ %d articles staged\n", len(staged))
-			fmt.Printf("ℹ️  %d files ignored (not Hashnode articles)\n\n", len(skipped))
-
-			// Clean up any old snapshots that were replaced during re-staging
-			snapStore := state.NewSnapshotStore()
-			if stats, cerr := snapStore.GC(false); cerr == nil && stats.RemovedCount > 0 {
-				fmt.Printf("🧹 Removed %d old snapshots\n", stats.RemovedCount)
-			}
-
-			fmt.Println("Next:")
-			fmt.Println("  • Review staged changes: hashnode stage list")
-			fmt.Println("  • Preview publish plan: hashnode plan")
-
-			if stageAddVerbose {
-				if len(staged) > 0 {
-					fmt.Println("Staged:")
-					for _, s := range staged {
-						fmt.Printf("  - %s\n", s)
-					}
-				}
-				if len(skipped) > 0 {
-					fmt.Println("Ignored:")
-					for _, s := range skipped {
-						fmt.Printf("  - %s\n", s)
-					}
-				}
-			}
-			return nil
+		if len(args) == 0 && !stageAll {
+			return fmt.Errorf("requires at least 1 path, or --all")
 		}
+		return runStage(args)
+	},
+}
 
-		// file: stage single file
-		if err := state.StageAdd(p); err != nil {
+// runStage expands args, selects the files to stage, lints and stages them.
+func runStage(args []string) error {
+	paths, err := state.ExpandStagePaths(args)
+	if err != nil {
+		return err
+	}
+	if stageAll {
+		paths = append(paths, state.ProjectRootOrCwd())
+	}
+	filter := state.StageFilter{ChangedOnly: stageChanged || stageAll, IncludeUntracked: stageIncludeUntracked}
+	sel, err := state.SelectStageFiles(paths, filter)
+	if err != nil {
+		return err
+	}
+	if len(sel.Files) > 0 {
+		if err := lintBeforeStage(sel.Files...); err != nil {
 			return err
 		}
-		fmt.Printf("✔ 1 article staged (%s)\n", state.NormalizePath(p))
+	}
+	if err := state.StageSelected(sel); err != nil {
+		return err
+	}
 
-		// Clean up any old snapshot that was replaced
-		snapStore := state.NewSnapshotStore()
-		if stats, cerr := snapStore.GC(false); cerr == nil && stats.RemovedCount > 0 {
-			fmt.Printf("🧹 Removed %d old snapshot(s)\n", stats.RemovedCount)
-		}
+	fmt.Printf("✔ %d file(s) staged\n", len(sel.Files))
+	if n := len(sel.Reverted); n > 0 {
+		fmt.Printf("ℹ️  %d file(s) unstaged (back to the published content)\n", n)
+	}
+	if n := len(sel.Unchanged); n > 0 {
+		fmt.Printf("ℹ️  %d unchanged file(s) left out\n", n)
+	}
+	if n := len(sel.Untracked); n > 0 {
+		fmt.Printf("ℹ️  %d untracked file(s) left out (use --include-untracked)\n", n)
+	}
+	if n := len(sel.Skipped); n > 0 {
+		fmt.Printf("ℹ️  %d file(s) ignored (not Hashnode articles)\n", n)
+	}
 
+	// Clean up any old snapshots that were replaced during re-staging
+	snapStore := state.NewSnapshotStore()
+	if stats, cerr := snapStore.GC(false); cerr == nil && stats.RemovedCount > 0 {
+		fmt.Printf("🧹 Removed %d old snapshot(s)\n", stats.RemovedCount)
+	}
+
+	if stageAddVerbose {
+		printPathList("Staged:", sel.Files)
+		printPathList("Unchanged:", sel.Unchanged)
+		printPathList("Unstaged:", sel.Reverted)
+		printPathList("Untracked:", sel.Untracked)
+		printPathList("Ignored:", sel.Skipped)
+	}
+	if len(sel.Files) > 0 {
 		fmt.Println("Next: hashnode stage list | hashnode plan")
-		return nil
-	},
+	}
+	return nil
+}
+
+func printPathList(heading string, paths []string) {
+	if len(paths) == 0 {
+		return
+	}
+	fmt.Println(heading)
+	for _, p := range paths {
+		fmt.Printf("  - %s\n", state.NormalizePath(p))
+	}
 }
 
 var stageAddVerbose bool
+var stageNoLint bool
+var stageChanged bool
+var stageAll bool
+var stageIncludeUntracked bool
 
 var deleteCmd = &cobra.Command{
 	Use:   "delete <path>",
@@ -144,13 +124,26 @@ var deleteCmd = &cobra.Command{
 			isDir = true
 		}
 
+		// Files already gone from disk are resolved through the ledger
+		sum, _ := state.LoadSum()
+		if err != nil {
+			tracked := state.TrackedPaths(sum, p)
+			if len(tracked) == 0 {
+				return fmt.Errorf("%s does not exist and is not tracked in %s", p, state.SumFile)
+			}
+			for _, t := range tracked {
+				if serr := state.StageRemove(filepath.Join(state.ProjectRootOrCwd(), filepath.FromSlash(t))); serr != nil {
+					return serr
+				}
+			}
+			fmt.Printf("✔ %d articles marked for deletion under %s\n", len(tracked), p)
+			return nil
+		}
+
 		if isDir {
 			// Walk directory and mark tracked markdown files for deletion
 			var marked int
-			err := filepath.WalkDir(p, func(path string, d os.DirEntry, err error) error {
-				if err != nil || d.IsDir() {
-					return nil
-				}
+			err := state.WalkContent(p, func(path string, d os.DirEntry) error {
 				ext := strings.ToLower(filepath.Ext(path))
 				if ext != ".md" && ext != ".markdown" {
 					return nil
@@ -163,6 +156,15 @@ var deleteCmd = &cobra.Command{
 			if err != nil {
 				return err
 			}
+			// Include tracked files under the directory that were already removed
+			for _, t := range state.TrackedPaths(sum, p) {
+				abs := filepath.Join(state.ProjectRootOrCwd(), filepath.FromSlash(t))
+				if _, serr := os.Stat(abs); os.IsNotExist(serr) {
+					if state.StageRemove(abs) == nil {
+						marked++
+					}
+				}
+			}
 			fmt.Printf("✔ %d articles marked for deletion under %s\n", marked, p)
 			return nil
 		}
@@ -386,5 +388,12 @@ func init() {
 	rootCmd.AddCommand(deleteCmd)
 	// top-level unstage convenience
 	rootCmd.AddCommand(unstageTopCmd)
+	stageCmd.PersistentFlags().BoolVar(&stageNoLint, "no-lint", false, "Stage without running lint checks")
+	stageCmd.Flags().BoolVarP(&stageAddVerbose, "verbose", "v", false, "Print every staged and skipped file")
+	for _, c := range []*cobra.Command{stageCmd, stageAddCmd} {
+		c.Flags().BoolVar(&stageChanged, "changed", false, "Stage only files that differ from hashnode.sum")
+		c.Flags().BoolVarP(&stageAll, "all", "A", false, "Stage every changed file in the repo (implies --changed)")
+		c.Flags().BoolVar(&stageIncludeUntracked, "include-untracked", false, "With --changed, also stage files not in hashnode.sum")
+	}
 	stageAddCmd.Flags().BoolVarP(&stageAddVerbose, "verbose", "v", false, "Print every staged and skipped file")
 }
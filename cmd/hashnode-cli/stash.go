@@ -0,0 +1,167 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+var stashMessage string
+var stashForce bool
+var stashDropYes bool
+var stashListVerbose bool
+
+var stashCmd = &cobra.Command{
+	Use:   "stash",
+	Short: "Set staged changes aside and bring them back later",
+	Long: `Move everything staged into a named stash under .hashnode/stash/ and
+clear the stage, so an unrelated change can be planned and applied first.
+Stashed snapshots are kept by gc until the stash is popped or dropped.
+
+Popping a stash refuses to replace items staged since it was pushed with
+different content, or to restage articles applied since then; pass --force
+to let the stash win.`,
+}
+
+var stashPushCmd = &cobra.Command{
+	Use:   "push [name]",
+	Short: "Move the staged items into a new stash",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		release, err := state.AcquireRepoLock()
+		if err != nil {
+			return fmt.Errorf("failed to acquire repo lock: %w", err)
+		}
+		defer func() {
+			if err := release(); err != nil {
+				fmt.Printf("warning: failed to remove lock: %v\n", err)
+			}
+		}()
+
+		name := ""
+		if len(args) == 1 {
+			name = args[0]
+		}
+		stash, err := state.StashPush(name, stashMessage)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✔ Stashed %d item(s) as %s\n", len(stash.Items), stash.Name)
+		return nil
+	},
+}
+
+var stashPopCmd = &cobra.Command{
+	Use:   "pop [name]",
+	Short: "Restage a stash (the newest by default) and drop it",
+	Args:  cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		release, err := state.AcquireRepoLock()
+		if err != nil {
+			return fmt.Errorf("failed to acquire repo lock: %w", err)
+		}
+		defer func() {
+			if err := release(); err != nil {
+				fmt.Printf("warning: failed to remove lock: %v\n", err)
+			}
+		}()
+
+		name := ""
+		if len(args) == 1 {
+			name = args[0]
+		}
+		stash, err := state.StashPop(name, stashForce)
+		var conflict *state.StashConflictError
+		if errors.As(err, &conflict) {
+			return fmt.Errorf("%w; resolve them, drop the stash or re-run with --force", err)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Printf("✔ Restaged %d item(s) from %s\n", len(stash.Items), stash.Name)
+		return nil
+	},
+}
+
+var stashListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List stashes, newest first",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		stashes, err := state.ListStashes()
+		if err != nil {
+			return err
+		}
+		if len(stashes) == 0 {
+			fmt.Println("No stashes.")
+			return nil
+		}
+		for _, s := range stashes {
+			line := fmt.Sprintf("%-24s %s  %d item(s)", s.Name, s.CreatedAt.Local().Format("2006-01-02 15:04"), len(s.Items))
+			if s.Message != "" {
+				line += "  " + s.Message
+			}
+			fmt.Println(line)
+			if stashListVerbose {
+				paths := make([]string, 0, len(s.Items))
+				for p := range s.Items {
+					paths = append(paths, p)
+				}
+				sort.Strings(paths)
+				for _, p := range paths {
+					fmt.Printf("    %-7s %s\n", s.Items[p].Operation, p)
+				}
+			}
+		}
+		return nil
+	},
+}
+
+var stashDropCmd = &cobra.Command{
+	Use:   "drop <name>",
+	Short: "Delete a stash and its staged changes",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		release, err := state.AcquireRepoLock()
+		if err != nil {
+			return fmt.Errorf("failed to acquire repo lock: %w", err)
+		}
+		defer func() {
+			if err := release(); err != nil {
+				fmt.Printf("warning: failed to remove lock: %v\n", err)
+			}
+		}()
+
+		stash, err := state.LoadStash(args[0])
+		if err != nil {
+			return err
+		}
+		if !stashDropYes && !confirm(fmt.Sprintf("Drop stash %s with %d item(s)?", stash.Name, len(stash.Items))) {
+			return fmt.Errorf("drop cancelled")
+		}
+		if err := state.DropStash(stash.Name); err != nil {
+			return err
+		}
+		fmt.Printf("✔ Dropped %s\n", stash.Name)
+		if stats, gerr := state.NewSnapshotStore().GC(false); gerr == nil && stats.RemovedCount > 0 {
+			fmt.Printf("🧹 Removed %d old snapshot(s)\n", stats.RemovedCount)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(stashCmd)
+	stashCmd.AddCommand(stashPushCmd)
+	stashCmd.AddCommand(stashPopCmd)
+	stashCmd.AddCommand(stashListCmd)
+	stashCmd.AddCommand(stashDropCmd)
+	stashPushCmd.Flags().StringVarP(&stashMessage, "message", "m", "", "Describe the stashed changes")
+	stashPopCmd.Flags().BoolVarP(&stashForce, "force", "f", false, "Replace conflicting staged items with the stashed ones")
+	stashListCmd.Flags().BoolVarP(&stashListVerbose, "verbose", "v", false, "List the stashed paths")
+	stashDropCmd.Flags().BoolVarP(&stashDropYes, "yes", "y", false, "Drop without asking")
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"adil-adysh/hashnode-cli/internal/api"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+var webhookCmd = &cobra.Command{
+	Use:   "webhook",
+	Short: "Manage publication webhooks",
+	Long: `Webhooks are declared in webhooks.yml at the repo root:
+
+  webhooks:
+    - name: deploy
+      url: https://ci.example.com/hooks/hashnode
+      events: [POST_PUBLISHED, POST_UPDATED, POST_DELETED]
+      secret_env: DEPLOY_WEBHOOK_SECRET
+
+Stage the file like an article; 'hashnode plan' and 'hashnode apply' create,
+update and delete webhooks to match. The secret is read from the named
+environment variable at apply time and never written to the repo.
+
+The Hashnode API cannot list webhooks, so hn tracks the ones it created in
+hashnode.sum. Webhooks created in the dashboard are not managed.`,
+}
+
+var webhookTestCmd = &cobra.Command{
+	Use:   "test <name>",
+	Short: "Trigger a test delivery for a webhook",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		s, err := state.LoadSum()
+		if err != nil {
+			return fmt.Errorf("failed to load hashnode.sum: %w", err)
+		}
+		hook, ok := s.Webhooks[args[0]]
+		if !ok {
+			names := make([]string, 0, len(s.Webhooks))
+			for name := range s.Webhooks {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			return fmt.Errorf("webhook %q has not been applied (known: %v)", args[0], names)
+		}
+		client, err := newAPIClient()
+		if err != nil {
+			return err
+		}
+		resp, err := api.TriggerWebhookTest(context.Background(), client, api.TriggerWebhookTestInput{WebhookId: hook.WebhookID})
+		if err != nil {
+			return fmt.Errorf("webhook test failed: %w", err)
+		}
+		target := hook.WebhookID
+		if resp != nil && resp.TriggerWebhookTest.Webhook != nil {
+			target = resp.TriggerWebhookTest.Webhook.Url
+		}
+		fmt.Printf("✔ Test delivery triggered for %s (%s)\n", args[0], target)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(webhookCmd)
+	webhookCmd.AddCommand(webhookTestCmd)
+}
@@ -4,6 +4,8 @@ package api
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/Khan/genqlient/graphql"
@@ -49,6 +51,76 @@ func (v *CoverImageOptionsInput) GetCoverImagePhotographer() *string { return v.
 // GetStickCoverToBottom returns CoverImageOptionsInput.StickCoverToBottom, and is useful for accessing the field via an interface.
 func (v *CoverImageOptionsInput) GetStickCoverToBottom() *bool { return v.StickCoverToBottom }
 
+// CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayload includes the requested fields of the GraphQL type CreateRedirectionRulePayload.
+type CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayload struct {
+	RedirectionRule CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayloadRedirectionRule `json:"redirectionRule"`
+}
+
+// GetRedirectionRule returns CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayload.RedirectionRule, and is useful for accessing the field via an interface.
+func (v *CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayload) GetRedirectionRule() CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayloadRedirectionRule {
+	return v.RedirectionRule
+}
+
+// CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayloadRedirectionRule includes the requested fields of the GraphQL type RedirectionRule.
+type CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayloadRedirectionRule struct {
+	Id string `json:"id"`
+	// The type of the redirection rule.
+	Type HttpRedirectionType `json:"type"`
+	// The source URL of the redirection rule.
+	Source string `json:"source"`
+	// The destination URL of the redirection rule.
+	Destination string `json:"destination"`
+}
+
+// GetId returns CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayloadRedirectionRule.Id, and is useful for accessing the field via an interface.
+func (v *CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayloadRedirectionRule) GetId() string {
+	return v.Id
+}
+
+// GetType returns CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayloadRedirectionRule.Type, and is useful for accessing the field via an interface.
+func (v *CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayloadRedirectionRule) GetType() HttpRedirectionType {
+	return v.Type
+}
+
+// GetSource returns CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayloadRedirectionRule.Source, and is useful for accessing the field via an interface.
+func (v *CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayloadRedirectionRule) GetSource() string {
+	return v.Source
+}
+
+// GetDestination returns CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayloadRedirectionRule.Destination, and is useful for accessing the field via an interface.
+func (v *CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayloadRedirectionRule) GetDestination() string {
+	return v.Destination
+}
+
+type CreateRedirectionRuleInput struct {
+	PublicationId string              `json:"publicationId"`
+	Source        string              `json:"source"`
+	Destination   string              `json:"destination"`
+	Type          HttpRedirectionType `json:"type"`
+}
+
+// GetPublicationId returns CreateRedirectionRuleInput.PublicationId, and is useful for accessing the field via an interface.
+func (v *CreateRedirectionRuleInput) GetPublicationId() string { return v.PublicationId }
+
+// GetSource returns CreateRedirectionRuleInput.Source, and is useful for accessing the field via an interface.
+func (v *CreateRedirectionRuleInput) GetSource() string { return v.Source }
+
+// GetDestination returns CreateRedirectionRuleInput.Destination, and is useful for accessing the field via an interface.
+func (v *CreateRedirectionRuleInput) GetDestination() string { return v.Destination }
+
+// GetType returns CreateRedirectionRuleInput.Type, and is useful for accessing the field via an interface.
+func (v *CreateRedirectionRuleInput) GetType() HttpRedirectionType { return v.Type }
+
+// CreateRedirectionRuleResponse is returned by CreateRedirectionRule on success.
+type CreateRedirectionRuleResponse struct {
+	CreateRedirectionRule CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayload `json:"createRedirectionRule"`
+}
+
+// GetCreateRedirectionRule returns CreateRedirectionRuleResponse.CreateRedirectionRule, and is useful for accessing the field via an interface.
+func (v *CreateRedirectionRuleResponse) GetCreateRedirectionRule() CreateRedirectionRuleCreateRedirectionRuleCreateRedirectionRulePayload {
+	return v.CreateRedirectionRule
+}
+
 // CreateSeriesCreateSeriesCreateSeriesPayload includes the requested fields of the GraphQL type CreateSeriesPayload.
 type CreateSeriesCreateSeriesCreateSeriesPayload struct {
 	// Returns the created series.
@@ -127,6 +199,200 @@ func (v *CreateSeriesResponse) GetCreateSeries() CreateSeriesCreateSeriesCreateS
 	return v.CreateSeries
 }
 
+// CreateWebhookCreateWebhookCreateWebhookPayload includes the requested fields of the GraphQL type CreateWebhookPayload.
+type CreateWebhookCreateWebhookCreateWebhookPayload struct {
+	Webhook *CreateWebhookCreateWebhookCreateWebhookPayloadWebhook `json:"webhook"`
+}
+
+// GetWebhook returns CreateWebhookCreateWebhookCreateWebhookPayload.Webhook, and is useful for accessing the field via an interface.
+func (v *CreateWebhookCreateWebhookCreateWebhookPayload) GetWebhook() *CreateWebhookCreateWebhookCreateWebhookPayloadWebhook {
+	return v.Webhook
+}
+
+// CreateWebhookCreateWebhookCreateWebhookPayloadWebhook includes the requested fields of the GraphQL type Webhook.
+type CreateWebhookCreateWebhookCreateWebhookPayloadWebhook struct {
+	// The ID of the post. Used to uniquely identify the post.
+	Id     string         `json:"id"`
+	Url    string         `json:"url"`
+	Events []WebhookEvent `json:"events"`
+}
+
+// GetId returns CreateWebhookCreateWebhookCreateWebhookPayloadWebhook.Id, and is useful for accessing the field via an interface.
+func (v *CreateWebhookCreateWebhookCreateWebhookPayloadWebhook) GetId() string { return v.Id }
+
+// GetUrl returns CreateWebhookCreateWebhookCreateWebhookPayloadWebhook.Url, and is useful for accessing the field via an interface.
+func (v *CreateWebhookCreateWebhookCreateWebhookPayloadWebhook) GetUrl() string { return v.Url }
+
+// GetEvents returns CreateWebhookCreateWebhookCreateWebhookPayloadWebhook.Events, and is useful for accessing the field via an interface.
+func (v *CreateWebhookCreateWebhookCreateWebhookPayloadWebhook) GetEvents() []WebhookEvent {
+	return v.Events
+}
+
+type CreateWebhookInput struct {
+	PublicationId string         `json:"publicationId"`
+	Url           string         `json:"url"`
+	Events        []WebhookEvent `json:"events"`
+	Secret        string         `json:"secret"`
+}
+
+// GetPublicationId returns CreateWebhookInput.PublicationId, and is useful for accessing the field via an interface.
+func (v *CreateWebhookInput) GetPublicationId() string { return v.PublicationId }
+
+// GetUrl returns CreateWebhookInput.Url, and is useful for accessing the field via an interface.
+func (v *CreateWebhookInput) GetUrl() string { return v.Url }
+
+// GetEvents returns CreateWebhookInput.Events, and is useful for accessing the field via an interface.
+func (v *CreateWebhookInput) GetEvents() []WebhookEvent { return v.Events }
+
+// GetSecret returns CreateWebhookInput.Secret, and is useful for accessing the field via an interface.
+func (v *CreateWebhookInput) GetSecret() string { return v.Secret }
+
+// CreateWebhookResponse is returned by CreateWebhook on success.
+type CreateWebhookResponse struct {
+	CreateWebhook CreateWebhookCreateWebhookCreateWebhookPayload `json:"createWebhook"`
+}
+
+// GetCreateWebhook returns CreateWebhookResponse.CreateWebhook, and is useful for accessing the field via an interface.
+func (v *CreateWebhookResponse) GetCreateWebhook() CreateWebhookCreateWebhookCreateWebhookPayload {
+	return v.CreateWebhook
+}
+
+// DeleteWebhookDeleteWebhookDeleteWebhookPayload includes the requested fields of the GraphQL type DeleteWebhookPayload.
+type DeleteWebhookDeleteWebhookDeleteWebhookPayload struct {
+	Webhook *DeleteWebhookDeleteWebhookDeleteWebhookPayloadWebhook `json:"webhook"`
+}
+
+// GetWebhook returns DeleteWebhookDeleteWebhookDeleteWebhookPayload.Webhook, and is useful for accessing the field via an interface.
+func (v *DeleteWebhookDeleteWebhookDeleteWebhookPayload) GetWebhook() *DeleteWebhookDeleteWebhookDeleteWebhookPayloadWebhook {
+	return v.Webhook
+}
+
+// DeleteWebhookDeleteWebhookDeleteWebhookPayloadWebhook includes the requested fields of the GraphQL type Webhook.
+type DeleteWebhookDeleteWebhookDeleteWebhookPayloadWebhook struct {
+	// The ID of the post. Used to uniquely identify the post.
+	Id string `json:"id"`
+}
+
+// GetId returns DeleteWebhookDeleteWebhookDeleteWebhookPayloadWebhook.Id, and is useful for accessing the field via an interface.
+func (v *DeleteWebhookDeleteWebhookDeleteWebhookPayloadWebhook) GetId() string { return v.Id }
+
+// DeleteWebhookResponse is returned by DeleteWebhook on success.
+type DeleteWebhookResponse struct {
+	DeleteWebhook DeleteWebhookDeleteWebhookDeleteWebhookPayload `json:"deleteWebhook"`
+}
+
+// GetDeleteWebhook returns DeleteWebhookResponse.DeleteWebhook, and is useful for accessing the field via an interface.
+func (v *DeleteWebhookResponse) GetDeleteWebhook() DeleteWebhookDeleteWebhookDeleteWebhookPayload {
+	return v.DeleteWebhook
+}
+
+// GetImportPostPost includes the requested fields of the GraphQL type Post.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the post.
+// A post is a published article on Hashnode.
+type GetImportPostPost struct {
+	ImportPost `json:"-"`
+}
+
+// GetId returns GetImportPostPost.Id, and is useful for accessing the field via an interface.
+func (v *GetImportPostPost) GetId() string { return v.ImportPost.Id }
+
+// GetTitle returns GetImportPostPost.Title, and is useful for accessing the field via an interface.
+func (v *GetImportPostPost) GetTitle() string { return v.ImportPost.Title }
+
+// GetSlug returns GetImportPostPost.Slug, and is useful for accessing the field via an interface.
+func (v *GetImportPostPost) GetSlug() string { return v.ImportPost.Slug }
+
+// GetPublishedAt returns GetImportPostPost.PublishedAt, and is useful for accessing the field via an interface.
+func (v *GetImportPostPost) GetPublishedAt() time.Time { return v.ImportPost.PublishedAt }
+
+// GetUpdatedAt returns GetImportPostPost.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetImportPostPost) GetUpdatedAt() *time.Time { return v.ImportPost.UpdatedAt }
+
+// GetContent returns GetImportPostPost.Content, and is useful for accessing the field via an interface.
+func (v *GetImportPostPost) GetContent() ImportPostContent { return v.ImportPost.Content }
+
+// GetTags returns GetImportPostPost.Tags, and is useful for accessing the field via an interface.
+func (v *GetImportPostPost) GetTags() []ImportPostTagsTag { return v.ImportPost.Tags }
+
+// GetSeries returns GetImportPostPost.Series, and is useful for accessing the field via an interface.
+func (v *GetImportPostPost) GetSeries() *ImportPostSeries { return v.ImportPost.Series }
+
+func (v *GetImportPostPost) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetImportPostPost
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetImportPostPost = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ImportPost)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetImportPostPost struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Slug string `json:"slug"`
+
+	PublishedAt time.Time `json:"publishedAt"`
+
+	UpdatedAt *time.Time `json:"updatedAt"`
+
+	Content ImportPostContent `json:"content"`
+
+	Tags []ImportPostTagsTag `json:"tags"`
+
+	Series *ImportPostSeries `json:"series"`
+}
+
+func (v *GetImportPostPost) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetImportPostPost) __premarshalJSON() (*__premarshalGetImportPostPost, error) {
+	var retval __premarshalGetImportPostPost
+
+	retval.Id = v.ImportPost.Id
+	retval.Title = v.ImportPost.Title
+	retval.Slug = v.ImportPost.Slug
+	retval.PublishedAt = v.ImportPost.PublishedAt
+	retval.UpdatedAt = v.ImportPost.UpdatedAt
+	retval.Content = v.ImportPost.Content
+	retval.Tags = v.ImportPost.Tags
+	retval.Series = v.ImportPost.Series
+	return &retval, nil
+}
+
+// GetImportPostResponse is returned by GetImportPost on success.
+type GetImportPostResponse struct {
+	// Returns post by ID. Can be used to render post page on blog.
+	Post *GetImportPostPost `json:"post"`
+}
+
+// GetPost returns GetImportPostResponse.Post, and is useful for accessing the field via an interface.
+func (v *GetImportPostResponse) GetPost() *GetImportPostPost { return v.Post }
+
 // GetMeMeMyUser includes the requested fields of the GraphQL type MyUser.
 // The GraphQL type's documentation follows.
 //
@@ -220,6 +486,148 @@ type GetMeResponse struct {
 // GetMe returns GetMeResponse.Me, and is useful for accessing the field via an interface.
 func (v *GetMeResponse) GetMe() GetMeMeMyUser { return v.Me }
 
+// GetPostPost includes the requested fields of the GraphQL type Post.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the post.
+// A post is a published article on Hashnode.
+type GetPostPost struct {
+	// The ID of the post. Used to uniquely identify the post.
+	Id string `json:"id"`
+	// The title of the post.
+	Title string `json:"title"`
+	// The subtitle of the post. Subtitle is a short description of the post which is also used in SEO if meta tags are not provided.
+	Subtitle *string `json:"subtitle"`
+	// The slug of the post. Used as address of the post on blog. Example - https://johndoe.com/my-post-slug
+	Slug string `json:"slug"`
+	// Canonical URL set by author in case of republished posts.
+	CanonicalUrl *string `json:"canonicalUrl"`
+	// Returns list of tags added to the post. Contains tag id, name, slug, etc.
+	Tags []GetPostPostTagsTag `json:"tags"`
+	// Information of the series the post belongs to.
+	Series *GetPostPostSeries `json:"series"`
+	// The cover image preference of the post. Contains cover image URL and other details.
+	CoverImage *GetPostPostCoverImage `json:"coverImage"`
+	// SEO information of the post. Contains title and description used in meta tags.
+	Seo *GetPostPostSeoSEO `json:"seo"`
+	// Content of the post. Contains HTML and Markdown version of the post content.
+	Content GetPostPostContent `json:"content"`
+	// The date and time the post was last updated.
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+// GetId returns GetPostPost.Id, and is useful for accessing the field via an interface.
+func (v *GetPostPost) GetId() string { return v.Id }
+
+// GetTitle returns GetPostPost.Title, and is useful for accessing the field via an interface.
+func (v *GetPostPost) GetTitle() string { return v.Title }
+
+// GetSubtitle returns GetPostPost.Subtitle, and is useful for accessing the field via an interface.
+func (v *GetPostPost) GetSubtitle() *string { return v.Subtitle }
+
+// GetSlug returns GetPostPost.Slug, and is useful for accessing the field via an interface.
+func (v *GetPostPost) GetSlug() string { return v.Slug }
+
+// GetCanonicalUrl returns GetPostPost.CanonicalUrl, and is useful for accessing the field via an interface.
+func (v *GetPostPost) GetCanonicalUrl() *string { return v.CanonicalUrl }
+
+// GetTags returns GetPostPost.Tags, and is useful for accessing the field via an interface.
+func (v *GetPostPost) GetTags() []GetPostPostTagsTag { return v.Tags }
+
+// GetSeries returns GetPostPost.Series, and is useful for accessing the field via an interface.
+func (v *GetPostPost) GetSeries() *GetPostPostSeries { return v.Series }
+
+// GetCoverImage returns GetPostPost.CoverImage, and is useful for accessing the field via an interface.
+func (v *GetPostPost) GetCoverImage() *GetPostPostCoverImage { return v.CoverImage }
+
+// GetSeo returns GetPostPost.Seo, and is useful for accessing the field via an interface.
+func (v *GetPostPost) GetSeo() *GetPostPostSeoSEO { return v.Seo }
+
+// GetContent returns GetPostPost.Content, and is useful for accessing the field via an interface.
+func (v *GetPostPost) GetContent() GetPostPostContent { return v.Content }
+
+// GetUpdatedAt returns GetPostPost.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetPostPost) GetUpdatedAt() *time.Time { return v.UpdatedAt }
+
+// GetPostPostContent includes the requested fields of the GraphQL type Content.
+type GetPostPostContent struct {
+	// The Markdown version of the content.
+	Markdown string `json:"markdown"`
+}
+
+// GetMarkdown returns GetPostPostContent.Markdown, and is useful for accessing the field via an interface.
+func (v *GetPostPostContent) GetMarkdown() string { return v.Markdown }
+
+// GetPostPostCoverImage includes the requested fields of the GraphQL type PostCoverImage.
+// The GraphQL type's documentation follows.
+//
+// Contains information about the cover image of the post.
+type GetPostPostCoverImage struct {
+	// The URL of the cover image.
+	Url string `json:"url"`
+}
+
+// GetUrl returns GetPostPostCoverImage.Url, and is useful for accessing the field via an interface.
+func (v *GetPostPostCoverImage) GetUrl() string { return v.Url }
+
+// GetPostPostSeoSEO includes the requested fields of the GraphQL type SEO.
+// The GraphQL type's documentation follows.
+//
+// Information to help in seo related meta tags.
+type GetPostPostSeoSEO struct {
+	// The title used in og:title tag for SEO purposes.
+	Title *string `json:"title"`
+	// The description used in og:description tag for SEO purposes.
+	Description *string `json:"description"`
+}
+
+// GetTitle returns GetPostPostSeoSEO.Title, and is useful for accessing the field via an interface.
+func (v *GetPostPostSeoSEO) GetTitle() *string { return v.Title }
+
+// GetDescription returns GetPostPostSeoSEO.Description, and is useful for accessing the field via an interface.
+func (v *GetPostPostSeoSEO) GetDescription() *string { return v.Description }
+
+// GetPostPostSeries includes the requested fields of the GraphQL type Series.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the series.
+// A series is a collection of posts that are related to each other.
+type GetPostPostSeries struct {
+	// The name of the series. Shown in series page.
+	Name string `json:"name"`
+	// The slug of the series. Used to access series page.  Example https://johndoe.com/series/series-slug
+	Slug string `json:"slug"`
+}
+
+// GetName returns GetPostPostSeries.Name, and is useful for accessing the field via an interface.
+func (v *GetPostPostSeries) GetName() string { return v.Name }
+
+// GetSlug returns GetPostPostSeries.Slug, and is useful for accessing the field via an interface.
+func (v *GetPostPostSeries) GetSlug() string { return v.Slug }
+
+// GetPostPostTagsTag includes the requested fields of the GraphQL type Tag.
+type GetPostPostTagsTag struct {
+	// The name of the tag. Shown in tag page.
+	Name string `json:"name"`
+	// The slug of the tag. Used to access tags feed.  Example https://hashnode.com/n/graphql
+	Slug string `json:"slug"`
+}
+
+// GetName returns GetPostPostTagsTag.Name, and is useful for accessing the field via an interface.
+func (v *GetPostPostTagsTag) GetName() string { return v.Name }
+
+// GetSlug returns GetPostPostTagsTag.Slug, and is useful for accessing the field via an interface.
+func (v *GetPostPostTagsTag) GetSlug() string { return v.Slug }
+
+// GetPostResponse is returned by GetPost on success.
+type GetPostResponse struct {
+	// Returns post by ID. Can be used to render post page on blog.
+	Post *GetPostPost `json:"post"`
+}
+
+// GetPost returns GetPostResponse.Post, and is useful for accessing the field via an interface.
+func (v *GetPostResponse) GetPost() *GetPostPost { return v.Post }
+
 // GetPublicationDataPublication includes the requested fields of the GraphQL type Publication.
 // The GraphQL type's documentation follows.
 //
@@ -291,80 +699,132 @@ func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdg
 // Contains basic information about the post.
 // A post is a published article on Hashnode.
 type GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost struct {
-	// The ID of the post. Used to uniquely identify the post.
-	Id string `json:"id"`
-	// The title of the post.
-	Title string `json:"title"`
-	// The slug of the post. Used as address of the post on blog. Example - https://johndoe.com/my-post-slug
-	Slug string `json:"slug"`
+	ImportPost `json:"-"`
 	// Brief is a short description of the post extracted from the content of the post. It's 250 characters long sanitized string.
 	Brief string `json:"brief"`
-	// The date and time the post was published.
-	PublishedAt time.Time `json:"publishedAt"`
-	// Content of the post. Contains HTML and Markdown version of the post content.
-	Content GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostContent `json:"content"`
-	// Returns list of tags added to the post. Contains tag id, name, slug, etc.
-	Tags []GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostTagsTag `json:"tags"`
-	// Information of the series the post belongs to.
-	Series *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries `json:"series"`
 	// The cover image preference of the post. Contains cover image URL and other details.
 	CoverImage *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostCoverImage `json:"coverImage"`
 }
 
+// GetBrief returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.Brief, and is useful for accessing the field via an interface.
+func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetBrief() string {
+	return v.Brief
+}
+
+// GetCoverImage returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.CoverImage, and is useful for accessing the field via an interface.
+func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetCoverImage() *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostCoverImage {
+	return v.CoverImage
+}
+
 // GetId returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.Id, and is useful for accessing the field via an interface.
 func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetId() string {
-	return v.Id
+	return v.ImportPost.Id
 }
 
 // GetTitle returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.Title, and is useful for accessing the field via an interface.
 func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetTitle() string {
-	return v.Title
+	return v.ImportPost.Title
 }
 
 // GetSlug returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.Slug, and is useful for accessing the field via an interface.
 func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetSlug() string {
-	return v.Slug
-}
-
-// GetBrief returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.Brief, and is useful for accessing the field via an interface.
-func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetBrief() string {
-	return v.Brief
+	return v.ImportPost.Slug
 }
 
 // GetPublishedAt returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.PublishedAt, and is useful for accessing the field via an interface.
 func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetPublishedAt() time.Time {
-	return v.PublishedAt
+	return v.ImportPost.PublishedAt
+}
+
+// GetUpdatedAt returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetUpdatedAt() *time.Time {
+	return v.ImportPost.UpdatedAt
 }
 
 // GetContent returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.Content, and is useful for accessing the field via an interface.
-func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetContent() GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostContent {
-	return v.Content
+func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetContent() ImportPostContent {
+	return v.ImportPost.Content
 }
 
 // GetTags returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.Tags, and is useful for accessing the field via an interface.
-func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetTags() []GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostTagsTag {
-	return v.Tags
+func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetTags() []ImportPostTagsTag {
+	return v.ImportPost.Tags
 }
 
 // GetSeries returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.Series, and is useful for accessing the field via an interface.
-func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetSeries() *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries {
-	return v.Series
+func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetSeries() *ImportPostSeries {
+	return v.ImportPost.Series
 }
 
-// GetCoverImage returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.CoverImage, and is useful for accessing the field via an interface.
-func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetCoverImage() *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostCoverImage {
-	return v.CoverImage
+func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ImportPost)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
-// GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostContent includes the requested fields of the GraphQL type Content.
-type GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostContent struct {
-	// The Markdown version of the content.
-	Markdown string `json:"markdown"`
+type __premarshalGetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost struct {
+	Brief string `json:"brief"`
+
+	CoverImage *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostCoverImage `json:"coverImage"`
+
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	Slug string `json:"slug"`
+
+	PublishedAt time.Time `json:"publishedAt"`
+
+	UpdatedAt *time.Time `json:"updatedAt"`
+
+	Content ImportPostContent `json:"content"`
+
+	Tags []ImportPostTagsTag `json:"tags"`
+
+	Series *ImportPostSeries `json:"series"`
 }
 
-// GetMarkdown returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostContent.Markdown, and is useful for accessing the field via an interface.
-func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostContent) GetMarkdown() string {
-	return v.Markdown
+func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) __premarshalJSON() (*__premarshalGetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost, error) {
+	var retval __premarshalGetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost
+
+	retval.Brief = v.Brief
+	retval.CoverImage = v.CoverImage
+	retval.Id = v.ImportPost.Id
+	retval.Title = v.ImportPost.Title
+	retval.Slug = v.ImportPost.Slug
+	retval.PublishedAt = v.ImportPost.PublishedAt
+	retval.UpdatedAt = v.ImportPost.UpdatedAt
+	retval.Content = v.ImportPost.Content
+	retval.Tags = v.ImportPost.Tags
+	retval.Series = v.ImportPost.Series
+	return &retval, nil
 }
 
 // GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostCoverImage includes the requested fields of the GraphQL type PostCoverImage.
@@ -381,54 +841,7 @@ func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdg
 	return v.Url
 }
 
-// GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries includes the requested fields of the GraphQL type Series.
-// The GraphQL type's documentation follows.
-//
-// Contains basic information about the series.
-// A series is a collection of posts that are related to each other.
-type GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries struct {
-	// The ID of the series.
-	Id string `json:"id"`
-	// The name of the series. Shown in series page.
-	Name string `json:"name"`
-	// The slug of the series. Used to access series page.  Example https://johndoe.com/series/series-slug
-	Slug string `json:"slug"`
-}
-
-// GetId returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries.Id, and is useful for accessing the field via an interface.
-func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries) GetId() string {
-	return v.Id
-}
-
-// GetName returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries.Name, and is useful for accessing the field via an interface.
-func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries) GetName() string {
-	return v.Name
-}
-
-// GetSlug returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries.Slug, and is useful for accessing the field via an interface.
-func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries) GetSlug() string {
-	return v.Slug
-}
-
-// GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostTagsTag includes the requested fields of the GraphQL type Tag.
-type GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostTagsTag struct {
-	// The name of the tag. Shown in tag page.
-	Name string `json:"name"`
-	// The slug of the tag. Used to access tags feed.  Example https://hashnode.com/n/graphql
-	Slug string `json:"slug"`
-}
-
-// GetName returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostTagsTag.Name, and is useful for accessing the field via an interface.
-func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostTagsTag) GetName() string {
-	return v.Name
-}
-
-// GetSlug returns GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostTagsTag.Slug, and is useful for accessing the field via an interface.
-func (v *GetPublicationDataPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostTagsTag) GetSlug() string {
-	return v.Slug
-}
-
-// GetPublicationDataPublicationPostsPublicationPostConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// GetPublicationDataPublicationPostsPublicationPostConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
 // The GraphQL type's documentation follows.
 //
 // Contains information to help in pagination.
@@ -486,45 +899,80 @@ func (v *GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdge)
 // Contains basic information about the series.
 // A series is a collection of posts that are related to each other.
 type GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries struct {
-	// The ID of the series.
-	Id string `json:"id"`
-	// The name of the series. Shown in series page.
-	Name string `json:"name"`
-	// The slug of the series. Used to access series page.  Example https://johndoe.com/series/series-slug
-	Slug string `json:"slug"`
-	// The description of the series. Contains markdown and html version of the series's description.
-	Description *GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeriesDescriptionContent `json:"description"`
+	ImportSeries `json:"-"`
 }
 
 // GetId returns GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries.Id, and is useful for accessing the field via an interface.
 func (v *GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries) GetId() string {
-	return v.Id
+	return v.ImportSeries.Id
 }
 
 // GetName returns GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries.Name, and is useful for accessing the field via an interface.
 func (v *GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries) GetName() string {
-	return v.Name
+	return v.ImportSeries.Name
 }
 
 // GetSlug returns GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries.Slug, and is useful for accessing the field via an interface.
 func (v *GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries) GetSlug() string {
-	return v.Slug
+	return v.ImportSeries.Slug
 }
 
 // GetDescription returns GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries.Description, and is useful for accessing the field via an interface.
-func (v *GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries) GetDescription() *GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeriesDescriptionContent {
-	return v.Description
+func (v *GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries) GetDescription() *ImportSeriesDescriptionContent {
+	return v.ImportSeries.Description
 }
 
-// GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeriesDescriptionContent includes the requested fields of the GraphQL type Content.
-type GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeriesDescriptionContent struct {
-	// The Markdown version of the content.
-	Markdown string `json:"markdown"`
+func (v *GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ImportSeries)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
-// GetMarkdown returns GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeriesDescriptionContent.Markdown, and is useful for accessing the field via an interface.
-func (v *GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeriesDescriptionContent) GetMarkdown() string {
-	return v.Markdown
+type __premarshalGetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Slug string `json:"slug"`
+
+	Description *ImportSeriesDescriptionContent `json:"description"`
+}
+
+func (v *GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries) __premarshalJSON() (*__premarshalGetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries, error) {
+	var retval __premarshalGetPublicationDataPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries
+
+	retval.Id = v.ImportSeries.Id
+	retval.Name = v.ImportSeries.Name
+	retval.Slug = v.ImportSeries.Slug
+	retval.Description = v.ImportSeries.Description
+	return &retval, nil
 }
 
 // GetPublicationDataResponse is returned by GetPublicationData on success.
@@ -539,505 +987,2810 @@ func (v *GetPublicationDataResponse) GetPublication() *GetPublicationDataPublica
 	return v.Publication
 }
 
-// Contains information about meta tags. Used for SEO purpose.
-type MetaTagsInput struct {
-	// The title of the post used in og:title for SEO.
-	Title *string `json:"title"`
-	// The description of the post used in og:description for SEO.
-	Description *string `json:"description"`
-	// The image URL of the post used in og:image for SEO.
-	Image *string `json:"image"`
+// GetPublicationDraftsPublication includes the requested fields of the GraphQL type Publication.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the publication.
+// A publication is a blog that can be created for a user or a team.
+type GetPublicationDraftsPublication struct {
+	// Returns the list of drafts of the authenticated user in the publication.
+	Drafts GetPublicationDraftsPublicationDraftsDraftConnection `json:"drafts"`
 }
 
-// GetTitle returns MetaTagsInput.Title, and is useful for accessing the field via an interface.
-func (v *MetaTagsInput) GetTitle() *string { return v.Title }
+// GetDrafts returns GetPublicationDraftsPublication.Drafts, and is useful for accessing the field via an interface.
+func (v *GetPublicationDraftsPublication) GetDrafts() GetPublicationDraftsPublicationDraftsDraftConnection {
+	return v.Drafts
+}
 
-// GetDescription returns MetaTagsInput.Description, and is useful for accessing the field via an interface.
-func (v *MetaTagsInput) GetDescription() *string { return v.Description }
+// GetPublicationDraftsPublicationDraftsDraftConnection includes the requested fields of the GraphQL type DraftConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection to get list of drafts.
+// Returns a list of edges which contains the draft and cursor to the last item of the previous page.
+type GetPublicationDraftsPublicationDraftsDraftConnection struct {
+	// A list of edges of drafts connection.
+	Edges []GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdge `json:"edges"`
+	// Information to aid in pagination.
+	PageInfo GetPublicationDraftsPublicationDraftsDraftConnectionPageInfo `json:"pageInfo"`
+}
 
-// GetImage returns MetaTagsInput.Image, and is useful for accessing the field via an interface.
-func (v *MetaTagsInput) GetImage() *string { return v.Image }
+// GetEdges returns GetPublicationDraftsPublicationDraftsDraftConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetPublicationDraftsPublicationDraftsDraftConnection) GetEdges() []GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdge {
+	return v.Edges
+}
 
-// Contains information about the post to be published.
-type PublishPostInput struct {
-	// The ID of the draft to be published.
-	// If an ID is provided, all other input fields are ignored. The corresponding draft is used to create the post.
-	DraftId *string `json:"draftId"`
-	// The title of the post.
-	Title string `json:"title"`
-	// The subtitle of the post.
-	Subtitle *string `json:"subtitle"`
-	// The ID of publication the post belongs to.
-	PublicationId string `json:"publicationId"`
-	// Content of the post in markdown format.
-	ContentMarkdown string `json:"contentMarkdown"`
-	// Date when the post is published.
-	PublishedAt *time.Time `json:"publishedAt"`
-	// Options for the cover image of the post.
-	CoverImageOptions *CoverImageOptionsInput `json:"coverImageOptions"`
-	// Options for the banner image of the post.
-	// It is similar to cover image but users can decide to render banner image of single post view.
-	BannerImageOptions *BannerImageOptionsInput `json:"bannerImageOptions"`
-	// Slug of the post.
-	Slug *string `json:"slug"`
-	// The URL of the original article if the post is imported from an external source.
-	OriginalArticleURL *string `json:"originalArticleURL"`
-	// A list of tags to add to the post. You can get a list of popular tags available on Hashnode here.
-	// https://github.com/Hashnode/support/blob/main/misc/tags.json
-	Tags []PublishPostTagInput `json:"tags"`
-	// A flag to indicate if the comments are disabled for the post.
-	DisableComments *bool `json:"disableComments"`
-	// Information about the meta tags added to the post, used for SEO purpose.
-	MetaTags *MetaTagsInput `json:"metaTags"`
-	// Publish the post on behalf of another user who is a member of the publication.
-	//
-	// Only applicable for team publications.
-	PublishAs *string `json:"publishAs"`
-	// Providing a seriesId will add the post to that series.
-	SeriesId *string `json:"seriesId"`
-	// Settings for the post like table of contents and newsletter activation.
-	Settings *PublishPostSettingsInput `json:"settings"`
-	// Ids of the co-authors of the post.
-	CoAuthors []string `json:"coAuthors"`
+// GetPageInfo returns GetPublicationDraftsPublicationDraftsDraftConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetPublicationDraftsPublicationDraftsDraftConnection) GetPageInfo() GetPublicationDraftsPublicationDraftsDraftConnectionPageInfo {
+	return v.PageInfo
 }
 
-// GetDraftId returns PublishPostInput.DraftId, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetDraftId() *string { return v.DraftId }
+// GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdge includes the requested fields of the GraphQL type DraftEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge that contains a node of type draft and cursor to the node.
+type GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdge struct {
+	// A node in the connection containing a draft.
+	Node GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft `json:"node"`
+}
 
-// GetTitle returns PublishPostInput.Title, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetTitle() string { return v.Title }
+// GetNode returns GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdge) GetNode() GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft {
+	return v.Node
+}
 
-// GetSubtitle returns PublishPostInput.Subtitle, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetSubtitle() *string { return v.Subtitle }
+// GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft includes the requested fields of the GraphQL type Draft.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the draft.
+// A draft is a post that is not published yet.
+type GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft struct {
+	ImportDraft `json:"-"`
+}
 
-// GetPublicationId returns PublishPostInput.PublicationId, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetPublicationId() string { return v.PublicationId }
+// GetId returns GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft.Id, and is useful for accessing the field via an interface.
+func (v *GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetId() string {
+	return v.ImportDraft.Id
+}
 
-// GetContentMarkdown returns PublishPostInput.ContentMarkdown, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetContentMarkdown() string { return v.ContentMarkdown }
+// GetSlug returns GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft.Slug, and is useful for accessing the field via an interface.
+func (v *GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetSlug() string {
+	return v.ImportDraft.Slug
+}
 
-// GetPublishedAt returns PublishPostInput.PublishedAt, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetPublishedAt() *time.Time { return v.PublishedAt }
+// GetTitle returns GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft.Title, and is useful for accessing the field via an interface.
+func (v *GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetTitle() *string {
+	return v.ImportDraft.Title
+}
 
-// GetCoverImageOptions returns PublishPostInput.CoverImageOptions, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetCoverImageOptions() *CoverImageOptionsInput { return v.CoverImageOptions }
+// GetUpdatedAt returns GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetUpdatedAt() time.Time {
+	return v.ImportDraft.UpdatedAt
+}
 
-// GetBannerImageOptions returns PublishPostInput.BannerImageOptions, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetBannerImageOptions() *BannerImageOptionsInput {
-	return v.BannerImageOptions
+// GetScheduledDate returns GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft.ScheduledDate, and is useful for accessing the field via an interface.
+func (v *GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetScheduledDate() *time.Time {
+	return v.ImportDraft.ScheduledDate
 }
 
-// GetSlug returns PublishPostInput.Slug, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetSlug() *string { return v.Slug }
+// GetContent returns GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft.Content, and is useful for accessing the field via an interface.
+func (v *GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetContent() *ImportDraftContent {
+	return v.ImportDraft.Content
+}
 
-// GetOriginalArticleURL returns PublishPostInput.OriginalArticleURL, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetOriginalArticleURL() *string { return v.OriginalArticleURL }
+// GetTagsV2 returns GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft.TagsV2, and is useful for accessing the field via an interface.
+func (v *GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetTagsV2() []ImportDraftTagsV2DraftTag {
+	return v.ImportDraft.TagsV2
+}
 
-// GetTags returns PublishPostInput.Tags, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetTags() []PublishPostTagInput { return v.Tags }
+// GetSeries returns GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft.Series, and is useful for accessing the field via an interface.
+func (v *GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetSeries() *ImportDraftSeries {
+	return v.ImportDraft.Series
+}
 
-// GetDisableComments returns PublishPostInput.DisableComments, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetDisableComments() *bool { return v.DisableComments }
+func (v *GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft) UnmarshalJSON(b []byte) error {
 
-// GetMetaTags returns PublishPostInput.MetaTags, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetMetaTags() *MetaTagsInput { return v.MetaTags }
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetPublishAs returns PublishPostInput.PublishAs, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetPublishAs() *string { return v.PublishAs }
+	var firstPass struct {
+		*GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft = v
 
-// GetSeriesId returns PublishPostInput.SeriesId, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetSeriesId() *string { return v.SeriesId }
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
 
-// GetSettings returns PublishPostInput.Settings, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetSettings() *PublishPostSettingsInput { return v.Settings }
+	err = json.Unmarshal(
+		b, &v.ImportDraft)
+	if err != nil {
+		return err
+	}
+	return nil
+}
 
-// GetCoAuthors returns PublishPostInput.CoAuthors, and is useful for accessing the field via an interface.
-func (v *PublishPostInput) GetCoAuthors() []string { return v.CoAuthors }
+type __premarshalGetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft struct {
+	Id string `json:"id"`
 
-// PublishPostPublishPostPublishPostPayload includes the requested fields of the GraphQL type PublishPostPayload.
-type PublishPostPublishPostPublishPostPayload struct {
-	Post *PublishPostPublishPostPublishPostPayloadPost `json:"post"`
+	Slug string `json:"slug"`
+
+	Title *string `json:"title"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	ScheduledDate *time.Time `json:"scheduledDate"`
+
+	Content *ImportDraftContent `json:"content"`
+
+	TagsV2 []json.RawMessage `json:"tagsV2"`
+
+	Series *ImportDraftSeries `json:"series"`
 }
 
-// GetPost returns PublishPostPublishPostPublishPostPayload.Post, and is useful for accessing the field via an interface.
-func (v *PublishPostPublishPostPublishPostPayload) GetPost() *PublishPostPublishPostPublishPostPayloadPost {
-	return v.Post
+func (v *GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// PublishPostPublishPostPublishPostPayloadPost includes the requested fields of the GraphQL type Post.
+func (v *GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft) __premarshalJSON() (*__premarshalGetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft, error) {
+	var retval __premarshalGetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft
+
+	retval.Id = v.ImportDraft.Id
+	retval.Slug = v.ImportDraft.Slug
+	retval.Title = v.ImportDraft.Title
+	retval.UpdatedAt = v.ImportDraft.UpdatedAt
+	retval.ScheduledDate = v.ImportDraft.ScheduledDate
+	retval.Content = v.ImportDraft.Content
+	{
+
+		dst := &retval.TagsV2
+		src := v.ImportDraft.TagsV2
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalImportDraftTagsV2DraftTag(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal GetPublicationDraftsPublicationDraftsDraftConnectionEdgesDraftEdgeNodeDraft.ImportDraft.TagsV2: %w", err)
+			}
+		}
+	}
+	retval.Series = v.ImportDraft.Series
+	return &retval, nil
+}
+
+// GetPublicationDraftsPublicationDraftsDraftConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
 // The GraphQL type's documentation follows.
 //
-// Contains basic information about the post.
-// A post is a published article on Hashnode.
-type PublishPostPublishPostPublishPostPayloadPost struct {
-	// The ID of the post. Used to uniquely identify the post.
-	Id string `json:"id"`
-	// The slug of the post. Used as address of the post on blog. Example - https://johndoe.com/my-post-slug
-	Slug string `json:"slug"`
-	// Complete URL of the post including the domain name. Example - https://johndoe.com/my-post-slug
-	Url string `json:"url"`
+// Contains information to help in pagination.
+type GetPublicationDraftsPublicationDraftsDraftConnectionPageInfo struct {
+	// Indicates if there are more pages.
+	HasNextPage *bool `json:"hasNextPage"`
+	// The cursor of the last item in the current page.
+	// Use it as the after input to query the next page.
+	EndCursor *string `json:"endCursor"`
 }
 
-// GetId returns PublishPostPublishPostPublishPostPayloadPost.Id, and is useful for accessing the field via an interface.
-func (v *PublishPostPublishPostPublishPostPayloadPost) GetId() string { return v.Id }
-
-// GetSlug returns PublishPostPublishPostPublishPostPayloadPost.Slug, and is useful for accessing the field via an interface.
-func (v *PublishPostPublishPostPublishPostPayloadPost) GetSlug() string { return v.Slug }
+// GetHasNextPage returns GetPublicationDraftsPublicationDraftsDraftConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetPublicationDraftsPublicationDraftsDraftConnectionPageInfo) GetHasNextPage() *bool {
+	return v.HasNextPage
+}
 
-// GetUrl returns PublishPostPublishPostPublishPostPayloadPost.Url, and is useful for accessing the field via an interface.
-func (v *PublishPostPublishPostPublishPostPayloadPost) GetUrl() string { return v.Url }
+// GetEndCursor returns GetPublicationDraftsPublicationDraftsDraftConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetPublicationDraftsPublicationDraftsDraftConnectionPageInfo) GetEndCursor() *string {
+	return v.EndCursor
+}
 
-// PublishPostResponse is returned by PublishPost on success.
-type PublishPostResponse struct {
-	// Creates a new post.
-	PublishPost PublishPostPublishPostPublishPostPayload `json:"publishPost"`
+// GetPublicationDraftsResponse is returned by GetPublicationDrafts on success.
+type GetPublicationDraftsResponse struct {
+	// Returns the publication with the given ID or host.
+	// User can pass anyone of them.
+	Publication *GetPublicationDraftsPublication `json:"publication"`
 }
 
-// GetPublishPost returns PublishPostResponse.PublishPost, and is useful for accessing the field via an interface.
-func (v *PublishPostResponse) GetPublishPost() PublishPostPublishPostPublishPostPayload {
-	return v.PublishPost
+// GetPublication returns GetPublicationDraftsResponse.Publication, and is useful for accessing the field via an interface.
+func (v *GetPublicationDraftsResponse) GetPublication() *GetPublicationDraftsPublication {
+	return v.Publication
 }
 
-type PublishPostSettingsInput struct {
-	// A flag to indicate if the post is scheduled.
-	Scheduled *bool `json:"scheduled"`
-	// A flag to indicate if the post contains table of content
-	EnableTableOfContent *bool `json:"enableTableOfContent"`
-	// Flag to indicate if the slug is overridden by the user.
-	SlugOverridden *bool `json:"slugOverridden"`
-	// Whether to send a newsletter for this post.
-	IsNewsletterActivated *bool `json:"isNewsletterActivated"`
-	// A flag to indicate if the post is delisted, used to hide the post from public feed.
-	Delisted *bool `json:"delisted"`
+// GetPublicationScheduledDraftsPublication includes the requested fields of the GraphQL type Publication.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the publication.
+// A publication is a blog that can be created for a user or a team.
+type GetPublicationScheduledDraftsPublication struct {
+	// Returns the scheduled drafts of the publication by the authenticated user.
+	ScheduledDrafts GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnection `json:"scheduledDrafts"`
 }
 
-// GetScheduled returns PublishPostSettingsInput.Scheduled, and is useful for accessing the field via an interface.
-func (v *PublishPostSettingsInput) GetScheduled() *bool { return v.Scheduled }
+// GetScheduledDrafts returns GetPublicationScheduledDraftsPublication.ScheduledDrafts, and is useful for accessing the field via an interface.
+func (v *GetPublicationScheduledDraftsPublication) GetScheduledDrafts() GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnection {
+	return v.ScheduledDrafts
+}
 
-// GetEnableTableOfContent returns PublishPostSettingsInput.EnableTableOfContent, and is useful for accessing the field via an interface.
-func (v *PublishPostSettingsInput) GetEnableTableOfContent() *bool { return v.EnableTableOfContent }
-
-// GetSlugOverridden returns PublishPostSettingsInput.SlugOverridden, and is useful for accessing the field via an interface.
-func (v *PublishPostSettingsInput) GetSlugOverridden() *bool { return v.SlugOverridden }
-
-// GetIsNewsletterActivated returns PublishPostSettingsInput.IsNewsletterActivated, and is useful for accessing the field via an interface.
-func (v *PublishPostSettingsInput) GetIsNewsletterActivated() *bool { return v.IsNewsletterActivated }
-
-// GetDelisted returns PublishPostSettingsInput.Delisted, and is useful for accessing the field via an interface.
-func (v *PublishPostSettingsInput) GetDelisted() *bool { return v.Delisted }
-
-type PublishPostTagInput struct {
-	// A tag id that is referencing an existing tag.
-	//
-	// Either this or name and slug should be provided. If both are provided, the id will be used.
-	Id *string `json:"id"`
-	// A slug of a new tag to create.
-	//
-	// Either this and name or id should be provided. If both are provided, the id will be used.
-	Slug *string `json:"slug"`
-	// A name of a new tag to create.
-	//
-	// Either this and slug or id should be provided. If both are provided, the id will be used.
-	Name *string `json:"name"`
+// GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnection includes the requested fields of the GraphQL type DraftConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection to get list of drafts.
+// Returns a list of edges which contains the draft and cursor to the last item of the previous page.
+type GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnection struct {
+	// A list of edges of drafts connection.
+	Edges []GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdge `json:"edges"`
+	// Information to aid in pagination.
+	PageInfo GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionPageInfo `json:"pageInfo"`
 }
 
-// GetId returns PublishPostTagInput.Id, and is useful for accessing the field via an interface.
-func (v *PublishPostTagInput) GetId() *string { return v.Id }
-
-// GetSlug returns PublishPostTagInput.Slug, and is useful for accessing the field via an interface.
-func (v *PublishPostTagInput) GetSlug() *string { return v.Slug }
-
-// GetName returns PublishPostTagInput.Name, and is useful for accessing the field via an interface.
-func (v *PublishPostTagInput) GetName() *string { return v.Name }
-
-type RemovePostInput struct {
-	// The ID of the post to remove.
-	Id string `json:"id"`
+// GetEdges returns GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnection) GetEdges() []GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdge {
+	return v.Edges
 }
 
-// GetId returns RemovePostInput.Id, and is useful for accessing the field via an interface.
-func (v *RemovePostInput) GetId() string { return v.Id }
+// GetPageInfo returns GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnection) GetPageInfo() GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionPageInfo {
+	return v.PageInfo
+}
 
-// RemovePostRemovePostRemovePostPayload includes the requested fields of the GraphQL type RemovePostPayload.
-type RemovePostRemovePostRemovePostPayload struct {
-	// The deleted post.
-	Post *RemovePostRemovePostRemovePostPayloadPost `json:"post"`
+// GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdge includes the requested fields of the GraphQL type DraftEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge that contains a node of type draft and cursor to the node.
+type GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdge struct {
+	// A node in the connection containing a draft.
+	Node GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft `json:"node"`
 }
 
-// GetPost returns RemovePostRemovePostRemovePostPayload.Post, and is useful for accessing the field via an interface.
-func (v *RemovePostRemovePostRemovePostPayload) GetPost() *RemovePostRemovePostRemovePostPayloadPost {
-	return v.Post
+// GetNode returns GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdge) GetNode() GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft {
+	return v.Node
 }
 
-// RemovePostRemovePostRemovePostPayloadPost includes the requested fields of the GraphQL type Post.
+// GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft includes the requested fields of the GraphQL type Draft.
 // The GraphQL type's documentation follows.
 //
-// Contains basic information about the post.
-// A post is a published article on Hashnode.
-type RemovePostRemovePostRemovePostPayloadPost struct {
-	// The ID of the post. Used to uniquely identify the post.
-	Id string `json:"id"`
+// Contains basic information about the draft.
+// A draft is a post that is not published yet.
+type GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft struct {
+	ImportDraft `json:"-"`
 }
 
-// GetId returns RemovePostRemovePostRemovePostPayloadPost.Id, and is useful for accessing the field via an interface.
-func (v *RemovePostRemovePostRemovePostPayloadPost) GetId() string { return v.Id }
-
-// RemovePostResponse is returned by RemovePost on success.
-type RemovePostResponse struct {
-	// Removes a post.
-	RemovePost RemovePostRemovePostRemovePostPayload `json:"removePost"`
+// GetId returns GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft.Id, and is useful for accessing the field via an interface.
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetId() string {
+	return v.ImportDraft.Id
 }
 
-// GetRemovePost returns RemovePostResponse.RemovePost, and is useful for accessing the field via an interface.
-func (v *RemovePostResponse) GetRemovePost() RemovePostRemovePostRemovePostPayload {
-	return v.RemovePost
+// GetSlug returns GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft.Slug, and is useful for accessing the field via an interface.
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetSlug() string {
+	return v.ImportDraft.Slug
 }
 
-// SortOrder is a common enum for all types that can be sorted.
-type SortOrder string
+// GetTitle returns GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft.Title, and is useful for accessing the field via an interface.
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetTitle() *string {
+	return v.ImportDraft.Title
+}
 
-const (
-	SortOrderAsc SortOrder = "asc"
-	SortOrderDsc SortOrder = "dsc"
-)
+// GetUpdatedAt returns GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetUpdatedAt() time.Time {
+	return v.ImportDraft.UpdatedAt
+}
 
-var AllSortOrder = []SortOrder{
-	SortOrderAsc,
-	SortOrderDsc,
+// GetScheduledDate returns GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft.ScheduledDate, and is useful for accessing the field via an interface.
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetScheduledDate() *time.Time {
+	return v.ImportDraft.ScheduledDate
 }
 
-type UpdatePostInput struct {
-	// The id of the post to update.
-	Id string `json:"id"`
-	// The new title of the post
-	Title *string `json:"title"`
-	// The subtitle of the post
-	Subtitle *string `json:"subtitle"`
-	// The publication the post is published to.
-	ContentMarkdown *string `json:"contentMarkdown"`
-	// Backdated publish date.
-	PublishedAt *time.Time `json:"publishedAt"`
-	// Options for the cover image of the post.
-	CoverImageOptions *CoverImageOptionsInput `json:"coverImageOptions"`
-	// Options for the banner image of the post.
-	BannerImageOptions *BannerImageOptionsInput `json:"bannerImageOptions"`
-	// Slug of the post. Only if you want to override the slug that will be generated based on the title.
-	Slug *string `json:"slug"`
-	// Canonical URL of the original article.
-	OriginalArticleURL *string `json:"originalArticleURL"`
-	// Tags to add to the post. New tags will be created if they don't exist. It overrides the existing tags.
-	Tags []PublishPostTagInput `json:"tags"`
-	// Information about the meta tags added to the post, used for SEO purpose.
-	MetaTags *MetaTagsInput `json:"metaTags"`
-	// Set a different author for the post than the requesting user.
-	// Must be a member of the publication.
-	PublishAs *string `json:"publishAs"`
-	// Update co-authors of the post.
-	// Must be a member of the publication.
-	CoAuthors []string `json:"coAuthors"`
-	// Providing a seriesId will add the post to that series.
-	// Must be a series of the publication.
-	SeriesId *string `json:"seriesId"`
-	// Whether or not to enable the table of content.
-	Settings *UpdatePostSettingsInput `json:"settings"`
-	// If the publication should be changed this is the new Publication ID
-	PublicationId *string `json:"publicationId"`
+// GetContent returns GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft.Content, and is useful for accessing the field via an interface.
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetContent() *ImportDraftContent {
+	return v.ImportDraft.Content
 }
 
-// GetId returns UpdatePostInput.Id, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetId() string { return v.Id }
+// GetTagsV2 returns GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft.TagsV2, and is useful for accessing the field via an interface.
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetTagsV2() []ImportDraftTagsV2DraftTag {
+	return v.ImportDraft.TagsV2
+}
 
-// GetTitle returns UpdatePostInput.Title, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetTitle() *string { return v.Title }
+// GetSeries returns GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft.Series, and is useful for accessing the field via an interface.
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft) GetSeries() *ImportDraftSeries {
+	return v.ImportDraft.Series
+}
 
-// GetSubtitle returns UpdatePostInput.Subtitle, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetSubtitle() *string { return v.Subtitle }
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft) UnmarshalJSON(b []byte) error {
 
-// GetContentMarkdown returns UpdatePostInput.ContentMarkdown, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetContentMarkdown() *string { return v.ContentMarkdown }
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetPublishedAt returns UpdatePostInput.PublishedAt, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetPublishedAt() *time.Time { return v.PublishedAt }
+	var firstPass struct {
+		*GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft = v
 
-// GetCoverImageOptions returns UpdatePostInput.CoverImageOptions, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetCoverImageOptions() *CoverImageOptionsInput { return v.CoverImageOptions }
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
 
-// GetBannerImageOptions returns UpdatePostInput.BannerImageOptions, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetBannerImageOptions() *BannerImageOptionsInput {
-	return v.BannerImageOptions
+	err = json.Unmarshal(
+		b, &v.ImportDraft)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
-// GetSlug returns UpdatePostInput.Slug, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetSlug() *string { return v.Slug }
-
-// GetOriginalArticleURL returns UpdatePostInput.OriginalArticleURL, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetOriginalArticleURL() *string { return v.OriginalArticleURL }
-
-// GetTags returns UpdatePostInput.Tags, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetTags() []PublishPostTagInput { return v.Tags }
+type __premarshalGetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft struct {
+	Id string `json:"id"`
 
-// GetMetaTags returns UpdatePostInput.MetaTags, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetMetaTags() *MetaTagsInput { return v.MetaTags }
+	Slug string `json:"slug"`
 
-// GetPublishAs returns UpdatePostInput.PublishAs, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetPublishAs() *string { return v.PublishAs }
+	Title *string `json:"title"`
 
-// GetCoAuthors returns UpdatePostInput.CoAuthors, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetCoAuthors() []string { return v.CoAuthors }
+	UpdatedAt time.Time `json:"updatedAt"`
 
-// GetSeriesId returns UpdatePostInput.SeriesId, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetSeriesId() *string { return v.SeriesId }
+	ScheduledDate *time.Time `json:"scheduledDate"`
 
-// GetSettings returns UpdatePostInput.Settings, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetSettings() *UpdatePostSettingsInput { return v.Settings }
+	Content *ImportDraftContent `json:"content"`
 
-// GetPublicationId returns UpdatePostInput.PublicationId, and is useful for accessing the field via an interface.
-func (v *UpdatePostInput) GetPublicationId() *string { return v.PublicationId }
+	TagsV2 []json.RawMessage `json:"tagsV2"`
 
-// UpdatePostResponse is returned by UpdatePost on success.
-type UpdatePostResponse struct {
-	UpdatePost UpdatePostUpdatePostUpdatePostPayload `json:"updatePost"`
+	Series *ImportDraftSeries `json:"series"`
 }
 
-// GetUpdatePost returns UpdatePostResponse.UpdatePost, and is useful for accessing the field via an interface.
-func (v *UpdatePostResponse) GetUpdatePost() UpdatePostUpdatePostUpdatePostPayload {
-	return v.UpdatePost
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-type UpdatePostSettingsInput struct {
-	// A flag to indicate if the post contains table of content
-	IsTableOfContentEnabled *bool `json:"isTableOfContentEnabled"`
-	// A flag to indicate if the post is delisted, used to hide the post from public feed.
-	Delisted *bool `json:"delisted"`
-	// Whether or not comments should be disabled.
-	DisableComments *bool `json:"disableComments"`
-	// Pin the post to the blog homepage.
-	PinToBlog *bool `json:"pinToBlog"`
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft) __premarshalJSON() (*__premarshalGetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft, error) {
+	var retval __premarshalGetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft
+
+	retval.Id = v.ImportDraft.Id
+	retval.Slug = v.ImportDraft.Slug
+	retval.Title = v.ImportDraft.Title
+	retval.UpdatedAt = v.ImportDraft.UpdatedAt
+	retval.ScheduledDate = v.ImportDraft.ScheduledDate
+	retval.Content = v.ImportDraft.Content
+	{
+
+		dst := &retval.TagsV2
+		src := v.ImportDraft.TagsV2
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalImportDraftTagsV2DraftTag(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionEdgesDraftEdgeNodeDraft.ImportDraft.TagsV2: %w", err)
+			}
+		}
+	}
+	retval.Series = v.ImportDraft.Series
+	return &retval, nil
 }
 
-// GetIsTableOfContentEnabled returns UpdatePostSettingsInput.IsTableOfContentEnabled, and is useful for accessing the field via an interface.
-func (v *UpdatePostSettingsInput) GetIsTableOfContentEnabled() *bool {
-	return v.IsTableOfContentEnabled
+// GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Contains information to help in pagination.
+type GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionPageInfo struct {
+	// Indicates if there are more pages.
+	HasNextPage *bool `json:"hasNextPage"`
+	// The cursor of the last item in the current page.
+	// Use it as the after input to query the next page.
+	EndCursor *string `json:"endCursor"`
 }
 
-// GetDelisted returns UpdatePostSettingsInput.Delisted, and is useful for accessing the field via an interface.
-func (v *UpdatePostSettingsInput) GetDelisted() *bool { return v.Delisted }
-
-// GetDisableComments returns UpdatePostSettingsInput.DisableComments, and is useful for accessing the field via an interface.
-func (v *UpdatePostSettingsInput) GetDisableComments() *bool { return v.DisableComments }
+// GetHasNextPage returns GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionPageInfo) GetHasNextPage() *bool {
+	return v.HasNextPage
+}
 
-// GetPinToBlog returns UpdatePostSettingsInput.PinToBlog, and is useful for accessing the field via an interface.
-func (v *UpdatePostSettingsInput) GetPinToBlog() *bool { return v.PinToBlog }
+// GetEndCursor returns GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetPublicationScheduledDraftsPublicationScheduledDraftsDraftConnectionPageInfo) GetEndCursor() *string {
+	return v.EndCursor
+}
 
-// UpdatePostUpdatePostUpdatePostPayload includes the requested fields of the GraphQL type UpdatePostPayload.
-type UpdatePostUpdatePostUpdatePostPayload struct {
-	Post *UpdatePostUpdatePostUpdatePostPayloadPost `json:"post"`
+// GetPublicationScheduledDraftsResponse is returned by GetPublicationScheduledDrafts on success.
+type GetPublicationScheduledDraftsResponse struct {
+	// Returns the publication with the given ID or host.
+	// User can pass anyone of them.
+	Publication *GetPublicationScheduledDraftsPublication `json:"publication"`
 }
 
-// GetPost returns UpdatePostUpdatePostUpdatePostPayload.Post, and is useful for accessing the field via an interface.
-func (v *UpdatePostUpdatePostUpdatePostPayload) GetPost() *UpdatePostUpdatePostUpdatePostPayloadPost {
-	return v.Post
+// GetPublication returns GetPublicationScheduledDraftsResponse.Publication, and is useful for accessing the field via an interface.
+func (v *GetPublicationScheduledDraftsResponse) GetPublication() *GetPublicationScheduledDraftsPublication {
+	return v.Publication
 }
 
-// UpdatePostUpdatePostUpdatePostPayloadPost includes the requested fields of the GraphQL type Post.
+// GetRedirectionRulesPublication includes the requested fields of the GraphQL type Publication.
 // The GraphQL type's documentation follows.
 //
-// Contains basic information about the post.
-// A post is a published article on Hashnode.
-type UpdatePostUpdatePostUpdatePostPayloadPost struct {
-	// The ID of the post. Used to uniquely identify the post.
-	Id string `json:"id"`
-	// The slug of the post. Used as address of the post on blog. Example - https://johndoe.com/my-post-slug
-	Slug string `json:"slug"`
-	// The date and time the post was last updated.
-	UpdatedAt *time.Time `json:"updatedAt"`
+// Contains basic information about the publication.
+// A publication is a blog that can be created for a user or a team.
+type GetRedirectionRulesPublication struct {
+	// Configured redirection rules for the publication.
+	RedirectionRules []GetRedirectionRulesPublicationRedirectionRulesRedirectionRule `json:"redirectionRules"`
 }
 
-// GetId returns UpdatePostUpdatePostUpdatePostPayloadPost.Id, and is useful for accessing the field via an interface.
-func (v *UpdatePostUpdatePostUpdatePostPayloadPost) GetId() string { return v.Id }
+// GetRedirectionRules returns GetRedirectionRulesPublication.RedirectionRules, and is useful for accessing the field via an interface.
+func (v *GetRedirectionRulesPublication) GetRedirectionRules() []GetRedirectionRulesPublicationRedirectionRulesRedirectionRule {
+	return v.RedirectionRules
+}
 
-// GetSlug returns UpdatePostUpdatePostUpdatePostPayloadPost.Slug, and is useful for accessing the field via an interface.
-func (v *UpdatePostUpdatePostUpdatePostPayloadPost) GetSlug() string { return v.Slug }
+// GetRedirectionRulesPublicationRedirectionRulesRedirectionRule includes the requested fields of the GraphQL type RedirectionRule.
+type GetRedirectionRulesPublicationRedirectionRulesRedirectionRule struct {
+	Id string `json:"id"`
+	// The type of the redirection rule.
+	Type HttpRedirectionType `json:"type"`
+	// The source URL of the redirection rule.
+	Source string `json:"source"`
+	// The destination URL of the redirection rule.
+	Destination string `json:"destination"`
+}
 
-// GetUpdatedAt returns UpdatePostUpdatePostUpdatePostPayloadPost.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *UpdatePostUpdatePostUpdatePostPayloadPost) GetUpdatedAt() *time.Time { return v.UpdatedAt }
+// GetId returns GetRedirectionRulesPublicationRedirectionRulesRedirectionRule.Id, and is useful for accessing the field via an interface.
+func (v *GetRedirectionRulesPublicationRedirectionRulesRedirectionRule) GetId() string { return v.Id }
 
-// __CreateSeriesInput is used internally by genqlient
-type __CreateSeriesInput struct {
-	Input CreateSeriesInput `json:"input"`
+// GetType returns GetRedirectionRulesPublicationRedirectionRulesRedirectionRule.Type, and is useful for accessing the field via an interface.
+func (v *GetRedirectionRulesPublicationRedirectionRulesRedirectionRule) GetType() HttpRedirectionType {
+	return v.Type
 }
 
-// GetInput returns __CreateSeriesInput.Input, and is useful for accessing the field via an interface.
-func (v *__CreateSeriesInput) GetInput() CreateSeriesInput { return v.Input }
-
-// __GetPublicationDataInput is used internally by genqlient
-type __GetPublicationDataInput struct {
-	Id    string  `json:"id"`
-	First int     `json:"first"`
-	After *string `json:"after"`
+// GetSource returns GetRedirectionRulesPublicationRedirectionRulesRedirectionRule.Source, and is useful for accessing the field via an interface.
+func (v *GetRedirectionRulesPublicationRedirectionRulesRedirectionRule) GetSource() string {
+	return v.Source
 }
 
-// GetId returns __GetPublicationDataInput.Id, and is useful for accessing the field via an interface.
-func (v *__GetPublicationDataInput) GetId() string { return v.Id }
+// GetDestination returns GetRedirectionRulesPublicationRedirectionRulesRedirectionRule.Destination, and is useful for accessing the field via an interface.
+func (v *GetRedirectionRulesPublicationRedirectionRulesRedirectionRule) GetDestination() string {
+	return v.Destination
+}
 
-// GetFirst returns __GetPublicationDataInput.First, and is useful for accessing the field via an interface.
-func (v *__GetPublicationDataInput) GetFirst() int { return v.First }
+// GetRedirectionRulesResponse is returned by GetRedirectionRules on success.
+type GetRedirectionRulesResponse struct {
+	// Returns the publication with the given ID or host.
+	// User can pass anyone of them.
+	Publication *GetRedirectionRulesPublication `json:"publication"`
+}
 
-// GetAfter returns __GetPublicationDataInput.After, and is useful for accessing the field via an interface.
-func (v *__GetPublicationDataInput) GetAfter() *string { return v.After }
+// GetPublication returns GetRedirectionRulesResponse.Publication, and is useful for accessing the field via an interface.
+func (v *GetRedirectionRulesResponse) GetPublication() *GetRedirectionRulesPublication {
+	return v.Publication
+}
 
-// __PublishPostInput is used internally by genqlient
+// GetStaticPagesPublication includes the requested fields of the GraphQL type Publication.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the publication.
+// A publication is a blog that can be created for a user or a team.
+type GetStaticPagesPublication struct {
+	// Returns a list of static pages in the publication.
+	StaticPages GetStaticPagesPublicationStaticPagesStaticPageConnection `json:"staticPages"`
+}
+
+// GetStaticPages returns GetStaticPagesPublication.StaticPages, and is useful for accessing the field via an interface.
+func (v *GetStaticPagesPublication) GetStaticPages() GetStaticPagesPublicationStaticPagesStaticPageConnection {
+	return v.StaticPages
+}
+
+// GetStaticPagesPublicationStaticPagesStaticPageConnection includes the requested fields of the GraphQL type StaticPageConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection to get list of static pages.
+// Returns a list of edges which contains the static page and cursor to the last item of the previous page.
+type GetStaticPagesPublicationStaticPagesStaticPageConnection struct {
+	// A list of edges containing nodes in the connection.
+	Edges []GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdge `json:"edges"`
+	// Information to aid in pagination.
+	PageInfo GetStaticPagesPublicationStaticPagesStaticPageConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetEdges returns GetStaticPagesPublicationStaticPagesStaticPageConnection.Edges, and is useful for accessing the field via an interface.
+func (v *GetStaticPagesPublicationStaticPagesStaticPageConnection) GetEdges() []GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns GetStaticPagesPublicationStaticPagesStaticPageConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *GetStaticPagesPublicationStaticPagesStaticPageConnection) GetPageInfo() GetStaticPagesPublicationStaticPagesStaticPageConnectionPageInfo {
+	return v.PageInfo
+}
+
+// GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdge includes the requested fields of the GraphQL type StaticPageEdge.
+// The GraphQL type's documentation follows.
+//
+// An edge that contains a node of type static page and cursor to the node.
+type GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdge struct {
+	// The node containing a static page.
+	Node GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPage `json:"node"`
+}
+
+// GetNode returns GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdge.Node, and is useful for accessing the field via an interface.
+func (v *GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdge) GetNode() GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPage {
+	return v.Node
+}
+
+// GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPage includes the requested fields of the GraphQL type StaticPage.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the static page.
+// Static pages are pages that are written in markdown and can be added to blog.
+type GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPage struct {
+	// The ID of the static page.
+	Id string `json:"id"`
+	// The title of the static page. Shown in nav bar.
+	Title string `json:"title"`
+	// The slug of the static page. Used to access static page. Example `https://johndoe.com/my-page`.
+	Slug string `json:"slug"`
+	// A flag to determine if the static page is hidden from public or not, this is used to hide the page instead of deleting it.
+	Hidden bool `json:"hidden"`
+	// Content of the static page. Contains markdown and html version of the static page's content.
+	Content GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPageContent `json:"content"`
+}
+
+// GetId returns GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPage.Id, and is useful for accessing the field via an interface.
+func (v *GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPage) GetId() string {
+	return v.Id
+}
+
+// GetTitle returns GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPage.Title, and is useful for accessing the field via an interface.
+func (v *GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPage) GetTitle() string {
+	return v.Title
+}
+
+// GetSlug returns GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPage.Slug, and is useful for accessing the field via an interface.
+func (v *GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPage) GetSlug() string {
+	return v.Slug
+}
+
+// GetHidden returns GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPage.Hidden, and is useful for accessing the field via an interface.
+func (v *GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPage) GetHidden() bool {
+	return v.Hidden
+}
+
+// GetContent returns GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPage.Content, and is useful for accessing the field via an interface.
+func (v *GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPage) GetContent() GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPageContent {
+	return v.Content
+}
+
+// GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPageContent includes the requested fields of the GraphQL type Content.
+type GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPageContent struct {
+	// The Markdown version of the content.
+	Markdown string `json:"markdown"`
+}
+
+// GetMarkdown returns GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPageContent.Markdown, and is useful for accessing the field via an interface.
+func (v *GetStaticPagesPublicationStaticPagesStaticPageConnectionEdgesStaticPageEdgeNodeStaticPageContent) GetMarkdown() string {
+	return v.Markdown
+}
+
+// GetStaticPagesPublicationStaticPagesStaticPageConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Contains information to help in pagination.
+type GetStaticPagesPublicationStaticPagesStaticPageConnectionPageInfo struct {
+	// Indicates if there are more pages.
+	HasNextPage *bool `json:"hasNextPage"`
+	// The cursor of the last item in the current page.
+	// Use it as the after input to query the next page.
+	EndCursor *string `json:"endCursor"`
+}
+
+// GetHasNextPage returns GetStaticPagesPublicationStaticPagesStaticPageConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *GetStaticPagesPublicationStaticPagesStaticPageConnectionPageInfo) GetHasNextPage() *bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns GetStaticPagesPublicationStaticPagesStaticPageConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *GetStaticPagesPublicationStaticPagesStaticPageConnectionPageInfo) GetEndCursor() *string {
+	return v.EndCursor
+}
+
+// GetStaticPagesResponse is returned by GetStaticPages on success.
+type GetStaticPagesResponse struct {
+	// Returns the publication with the given ID or host.
+	// User can pass anyone of them.
+	Publication *GetStaticPagesPublication `json:"publication"`
+}
+
+// GetPublication returns GetStaticPagesResponse.Publication, and is useful for accessing the field via an interface.
+func (v *GetStaticPagesResponse) GetPublication() *GetStaticPagesPublication { return v.Publication }
+
+type HttpRedirectionType string
+
+const (
+	// A temporary redirect that corresponds to the 301 HTTP status code.
+	HttpRedirectionTypeTemporary HttpRedirectionType = "TEMPORARY"
+	// A permanent redirect that corresponds to the 302 HTTP status code.
+	HttpRedirectionTypePermanent HttpRedirectionType = "PERMANENT"
+)
+
+var AllHttpRedirectionType = []HttpRedirectionType{
+	HttpRedirectionTypeTemporary,
+	HttpRedirectionTypePermanent,
+}
+
+// Drafts and scheduled drafts are imported as unpublished articles
+type ImportDraft struct {
+	// The ID of the draft.
+	Id   string `json:"id"`
+	Slug string `json:"slug"`
+	// The title of the draft. It would become the title of the post when published.
+	Title     *string   `json:"title"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The date the draft is scheduled to be published.
+	ScheduledDate *time.Time `json:"scheduledDate"`
+	// Content of the draft in HTML and markdown
+	Content *ImportDraftContent         `json:"content"`
+	TagsV2  []ImportDraftTagsV2DraftTag `json:"-"`
+	// Information of the series the draft belongs to.
+	Series *ImportDraftSeries `json:"series"`
+}
+
+// GetId returns ImportDraft.Id, and is useful for accessing the field via an interface.
+func (v *ImportDraft) GetId() string { return v.Id }
+
+// GetSlug returns ImportDraft.Slug, and is useful for accessing the field via an interface.
+func (v *ImportDraft) GetSlug() string { return v.Slug }
+
+// GetTitle returns ImportDraft.Title, and is useful for accessing the field via an interface.
+func (v *ImportDraft) GetTitle() *string { return v.Title }
+
+// GetUpdatedAt returns ImportDraft.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ImportDraft) GetUpdatedAt() time.Time { return v.UpdatedAt }
+
+// GetScheduledDate returns ImportDraft.ScheduledDate, and is useful for accessing the field via an interface.
+func (v *ImportDraft) GetScheduledDate() *time.Time { return v.ScheduledDate }
+
+// GetContent returns ImportDraft.Content, and is useful for accessing the field via an interface.
+func (v *ImportDraft) GetContent() *ImportDraftContent { return v.Content }
+
+// GetTagsV2 returns ImportDraft.TagsV2, and is useful for accessing the field via an interface.
+func (v *ImportDraft) GetTagsV2() []ImportDraftTagsV2DraftTag { return v.TagsV2 }
+
+// GetSeries returns ImportDraft.Series, and is useful for accessing the field via an interface.
+func (v *ImportDraft) GetSeries() *ImportDraftSeries { return v.Series }
+
+func (v *ImportDraft) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ImportDraft
+		TagsV2 []json.RawMessage `json:"tagsV2"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ImportDraft = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.TagsV2
+		src := firstPass.TagsV2
+		*dst = make(
+			[]ImportDraftTagsV2DraftTag,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalImportDraftTagsV2DraftTag(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal ImportDraft.TagsV2: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalImportDraft struct {
+	Id string `json:"id"`
+
+	Slug string `json:"slug"`
+
+	Title *string `json:"title"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	ScheduledDate *time.Time `json:"scheduledDate"`
+
+	Content *ImportDraftContent `json:"content"`
+
+	TagsV2 []json.RawMessage `json:"tagsV2"`
+
+	Series *ImportDraftSeries `json:"series"`
+}
+
+func (v *ImportDraft) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ImportDraft) __premarshalJSON() (*__premarshalImportDraft, error) {
+	var retval __premarshalImportDraft
+
+	retval.Id = v.Id
+	retval.Slug = v.Slug
+	retval.Title = v.Title
+	retval.UpdatedAt = v.UpdatedAt
+	retval.ScheduledDate = v.ScheduledDate
+	retval.Content = v.Content
+	{
+
+		dst := &retval.TagsV2
+		src := v.TagsV2
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalImportDraftTagsV2DraftTag(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal ImportDraft.TagsV2: %w", err)
+			}
+		}
+	}
+	retval.Series = v.Series
+	return &retval, nil
+}
+
+// ImportDraftContent includes the requested fields of the GraphQL type Content.
+type ImportDraftContent struct {
+	// The Markdown version of the content.
+	Markdown string `json:"markdown"`
+}
+
+// GetMarkdown returns ImportDraftContent.Markdown, and is useful for accessing the field via an interface.
+func (v *ImportDraftContent) GetMarkdown() string { return v.Markdown }
+
+// ImportDraftSeries includes the requested fields of the GraphQL type Series.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the series.
+// A series is a collection of posts that are related to each other.
+type ImportDraftSeries struct {
+	// The ID of the series.
+	Id string `json:"id"`
+	// The name of the series. Shown in series page.
+	Name string `json:"name"`
+	// The slug of the series. Used to access series page.  Example https://johndoe.com/series/series-slug
+	Slug string `json:"slug"`
+}
+
+// GetId returns ImportDraftSeries.Id, and is useful for accessing the field via an interface.
+func (v *ImportDraftSeries) GetId() string { return v.Id }
+
+// GetName returns ImportDraftSeries.Name, and is useful for accessing the field via an interface.
+func (v *ImportDraftSeries) GetName() string { return v.Name }
+
+// GetSlug returns ImportDraftSeries.Slug, and is useful for accessing the field via an interface.
+func (v *ImportDraftSeries) GetSlug() string { return v.Slug }
+
+// ImportDraftTagsV2DraftBaseTag includes the requested fields of the GraphQL type DraftBaseTag.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about a Tag within a Draft.
+// A tag in a draft is a tag that is not published yet.
+type ImportDraftTagsV2DraftBaseTag struct {
+	Typename *string `json:"__typename"`
+	// The slug of the tag. Used to access tags feed.  Example https://hashnode.com/n/graphql
+	Slug string `json:"slug"`
+}
+
+// GetTypename returns ImportDraftTagsV2DraftBaseTag.Typename, and is useful for accessing the field via an interface.
+func (v *ImportDraftTagsV2DraftBaseTag) GetTypename() *string { return v.Typename }
+
+// GetSlug returns ImportDraftTagsV2DraftBaseTag.Slug, and is useful for accessing the field via an interface.
+func (v *ImportDraftTagsV2DraftBaseTag) GetSlug() string { return v.Slug }
+
+// ImportDraftTagsV2DraftTag includes the requested fields of the GraphQL interface DraftTag.
+//
+// ImportDraftTagsV2DraftTag is implemented by the following types:
+// ImportDraftTagsV2DraftBaseTag
+// ImportDraftTagsV2Tag
+type ImportDraftTagsV2DraftTag interface {
+	implementsGraphQLInterfaceImportDraftTagsV2DraftTag()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *ImportDraftTagsV2DraftBaseTag) implementsGraphQLInterfaceImportDraftTagsV2DraftTag() {}
+func (v *ImportDraftTagsV2Tag) implementsGraphQLInterfaceImportDraftTagsV2DraftTag()          {}
+
+func __unmarshalImportDraftTagsV2DraftTag(b []byte, v *ImportDraftTagsV2DraftTag) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "DraftBaseTag":
+		*v = new(ImportDraftTagsV2DraftBaseTag)
+		return json.Unmarshal(b, *v)
+	case "Tag":
+		*v = new(ImportDraftTagsV2Tag)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing DraftTag.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ImportDraftTagsV2DraftTag: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalImportDraftTagsV2DraftTag(v *ImportDraftTagsV2DraftTag) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ImportDraftTagsV2DraftBaseTag:
+		typename = "DraftBaseTag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ImportDraftTagsV2DraftBaseTag
+		}{typename, v}
+		return json.Marshal(result)
+	case *ImportDraftTagsV2Tag:
+		typename = "Tag"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ImportDraftTagsV2Tag
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ImportDraftTagsV2DraftTag: "%T"`, v)
+	}
+}
+
+// ImportDraftTagsV2Tag includes the requested fields of the GraphQL type Tag.
+type ImportDraftTagsV2Tag struct {
+	Typename *string `json:"__typename"`
+	// The slug of the tag. Used to access tags feed.  Example https://hashnode.com/n/graphql
+	Slug string `json:"slug"`
+}
+
+// GetTypename returns ImportDraftTagsV2Tag.Typename, and is useful for accessing the field via an interface.
+func (v *ImportDraftTagsV2Tag) GetTypename() *string { return v.Typename }
+
+// GetSlug returns ImportDraftTagsV2Tag.Slug, and is useful for accessing the field via an interface.
+func (v *ImportDraftTagsV2Tag) GetSlug() string { return v.Slug }
+
+// --- 2. Content State & Import Engine ---
+// Fields import writes and records for a post
+type ImportPost struct {
+	// The ID of the post. Used to uniquely identify the post.
+	Id string `json:"id"`
+	// The title of the post.
+	Title string `json:"title"`
+	// The slug of the post. Used as address of the post on blog. Example - https://johndoe.com/my-post-slug
+	Slug string `json:"slug"`
+	// The date and time the post was published.
+	PublishedAt time.Time `json:"publishedAt"`
+	// The date and time the post was last updated.
+	UpdatedAt *time.Time `json:"updatedAt"`
+	// Content of the post. Contains HTML and Markdown version of the post content.
+	Content ImportPostContent `json:"content"`
+	// Returns list of tags added to the post. Contains tag id, name, slug, etc.
+	Tags []ImportPostTagsTag `json:"tags"`
+	// Information of the series the post belongs to.
+	Series *ImportPostSeries `json:"series"`
+}
+
+// GetId returns ImportPost.Id, and is useful for accessing the field via an interface.
+func (v *ImportPost) GetId() string { return v.Id }
+
+// GetTitle returns ImportPost.Title, and is useful for accessing the field via an interface.
+func (v *ImportPost) GetTitle() string { return v.Title }
+
+// GetSlug returns ImportPost.Slug, and is useful for accessing the field via an interface.
+func (v *ImportPost) GetSlug() string { return v.Slug }
+
+// GetPublishedAt returns ImportPost.PublishedAt, and is useful for accessing the field via an interface.
+func (v *ImportPost) GetPublishedAt() time.Time { return v.PublishedAt }
+
+// GetUpdatedAt returns ImportPost.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ImportPost) GetUpdatedAt() *time.Time { return v.UpdatedAt }
+
+// GetContent returns ImportPost.Content, and is useful for accessing the field via an interface.
+func (v *ImportPost) GetContent() ImportPostContent { return v.Content }
+
+// GetTags returns ImportPost.Tags, and is useful for accessing the field via an interface.
+func (v *ImportPost) GetTags() []ImportPostTagsTag { return v.Tags }
+
+// GetSeries returns ImportPost.Series, and is useful for accessing the field via an interface.
+func (v *ImportPost) GetSeries() *ImportPostSeries { return v.Series }
+
+// ImportPostContent includes the requested fields of the GraphQL type Content.
+type ImportPostContent struct {
+	// The Markdown version of the content.
+	Markdown string `json:"markdown"`
+}
+
+// GetMarkdown returns ImportPostContent.Markdown, and is useful for accessing the field via an interface.
+func (v *ImportPostContent) GetMarkdown() string { return v.Markdown }
+
+// ImportPostSeries includes the requested fields of the GraphQL type Series.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the series.
+// A series is a collection of posts that are related to each other.
+type ImportPostSeries struct {
+	// The ID of the series.
+	Id string `json:"id"`
+	// The name of the series. Shown in series page.
+	Name string `json:"name"`
+	// The slug of the series. Used to access series page.  Example https://johndoe.com/series/series-slug
+	Slug string `json:"slug"`
+}
+
+// GetId returns ImportPostSeries.Id, and is useful for accessing the field via an interface.
+func (v *ImportPostSeries) GetId() string { return v.Id }
+
+// GetName returns ImportPostSeries.Name, and is useful for accessing the field via an interface.
+func (v *ImportPostSeries) GetName() string { return v.Name }
+
+// GetSlug returns ImportPostSeries.Slug, and is useful for accessing the field via an interface.
+func (v *ImportPostSeries) GetSlug() string { return v.Slug }
+
+// ImportPostTagsTag includes the requested fields of the GraphQL type Tag.
+type ImportPostTagsTag struct {
+	// The name of the tag. Shown in tag page.
+	Name string `json:"name"`
+	// The slug of the tag. Used to access tags feed.  Example https://hashnode.com/n/graphql
+	Slug string `json:"slug"`
+}
+
+// GetName returns ImportPostTagsTag.Name, and is useful for accessing the field via an interface.
+func (v *ImportPostTagsTag) GetName() string { return v.Name }
+
+// GetSlug returns ImportPostTagsTag.Slug, and is useful for accessing the field via an interface.
+func (v *ImportPostTagsTag) GetSlug() string { return v.Slug }
+
+// Series metadata merged into the ledger by import
+type ImportSeries struct {
+	// The ID of the series.
+	Id string `json:"id"`
+	// The name of the series. Shown in series page.
+	Name string `json:"name"`
+	// The slug of the series. Used to access series page.  Example https://johndoe.com/series/series-slug
+	Slug string `json:"slug"`
+	// The description of the series. Contains markdown and html version of the series's description.
+	Description *ImportSeriesDescriptionContent `json:"description"`
+}
+
+// GetId returns ImportSeries.Id, and is useful for accessing the field via an interface.
+func (v *ImportSeries) GetId() string { return v.Id }
+
+// GetName returns ImportSeries.Name, and is useful for accessing the field via an interface.
+func (v *ImportSeries) GetName() string { return v.Name }
+
+// GetSlug returns ImportSeries.Slug, and is useful for accessing the field via an interface.
+func (v *ImportSeries) GetSlug() string { return v.Slug }
+
+// GetDescription returns ImportSeries.Description, and is useful for accessing the field via an interface.
+func (v *ImportSeries) GetDescription() *ImportSeriesDescriptionContent { return v.Description }
+
+// ImportSeriesDescriptionContent includes the requested fields of the GraphQL type Content.
+type ImportSeriesDescriptionContent struct {
+	// The Markdown version of the content.
+	Markdown string `json:"markdown"`
+}
+
+// GetMarkdown returns ImportSeriesDescriptionContent.Markdown, and is useful for accessing the field via an interface.
+func (v *ImportSeriesDescriptionContent) GetMarkdown() string { return v.Markdown }
+
+// ListPublicationPostsPublication includes the requested fields of the GraphQL type Publication.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the publication.
+// A publication is a blog that can be created for a user or a team.
+type ListPublicationPostsPublication struct {
+	// Returns the list of posts in the publication.
+	Posts ListPublicationPostsPublicationPostsPublicationPostConnection `json:"posts"`
+	// Returns the list of series in the publication.
+	SeriesList ListPublicationPostsPublicationSeriesListSeriesConnection `json:"seriesList"`
+}
+
+// GetPosts returns ListPublicationPostsPublication.Posts, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublication) GetPosts() ListPublicationPostsPublicationPostsPublicationPostConnection {
+	return v.Posts
+}
+
+// GetSeriesList returns ListPublicationPostsPublication.SeriesList, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublication) GetSeriesList() ListPublicationPostsPublicationSeriesListSeriesConnection {
+	return v.SeriesList
+}
+
+// ListPublicationPostsPublicationPostsPublicationPostConnection includes the requested fields of the GraphQL type PublicationPostConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection for posts within a publication. Contains a list of edges containing nodes.
+// Each node is a post.
+// Page info contains information about pagination like hasNextPage and endCursor.
+type ListPublicationPostsPublicationPostsPublicationPostConnection struct {
+	// A list of edges containing Post information
+	Edges []ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdge `json:"edges"`
+	// Information for pagination in Post connection.
+	PageInfo ListPublicationPostsPublicationPostsPublicationPostConnectionPageInfo `json:"pageInfo"`
+}
+
+// GetEdges returns ListPublicationPostsPublicationPostsPublicationPostConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationPostsPublicationPostConnection) GetEdges() []ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns ListPublicationPostsPublicationPostsPublicationPostConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationPostsPublicationPostConnection) GetPageInfo() ListPublicationPostsPublicationPostsPublicationPostConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdge includes the requested fields of the GraphQL type PostEdge.
+// The GraphQL type's documentation follows.
+//
+// Contains a post and a cursor for pagination.
+type ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdge struct {
+	// The node holding the Post information
+	Node ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost `json:"node"`
+}
+
+// GetNode returns ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdge.Node, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdge) GetNode() ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost {
+	return v.Node
+}
+
+// ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost includes the requested fields of the GraphQL type Post.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the post.
+// A post is a published article on Hashnode.
+type ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost struct {
+	// The ID of the post. Used to uniquely identify the post.
+	Id string `json:"id"`
+	// The slug of the post. Used as address of the post on blog. Example - https://johndoe.com/my-post-slug
+	Slug string `json:"slug"`
+	// The date and time the post was published.
+	PublishedAt time.Time `json:"publishedAt"`
+	// The date and time the post was last updated.
+	UpdatedAt *time.Time `json:"updatedAt"`
+	// Information of the series the post belongs to.
+	Series *ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries `json:"series"`
+}
+
+// GetId returns ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.Id, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetId() string {
+	return v.Id
+}
+
+// GetSlug returns ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.Slug, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetSlug() string {
+	return v.Slug
+}
+
+// GetPublishedAt returns ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.PublishedAt, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetPublishedAt() time.Time {
+	return v.PublishedAt
+}
+
+// GetUpdatedAt returns ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetUpdatedAt() *time.Time {
+	return v.UpdatedAt
+}
+
+// GetSeries returns ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost.Series, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePost) GetSeries() *ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries {
+	return v.Series
+}
+
+// ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries includes the requested fields of the GraphQL type Series.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the series.
+// A series is a collection of posts that are related to each other.
+type ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries struct {
+	// The name of the series. Shown in series page.
+	Name string `json:"name"`
+	// The slug of the series. Used to access series page.  Example https://johndoe.com/series/series-slug
+	Slug string `json:"slug"`
+}
+
+// GetName returns ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries.Name, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries) GetName() string {
+	return v.Name
+}
+
+// GetSlug returns ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries.Slug, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationPostsPublicationPostConnectionEdgesPostEdgeNodePostSeries) GetSlug() string {
+	return v.Slug
+}
+
+// ListPublicationPostsPublicationPostsPublicationPostConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Contains information to help in pagination.
+type ListPublicationPostsPublicationPostsPublicationPostConnectionPageInfo struct {
+	// Indicates if there are more pages.
+	HasNextPage *bool `json:"hasNextPage"`
+	// The cursor of the last item in the current page.
+	// Use it as the after input to query the next page.
+	EndCursor *string `json:"endCursor"`
+}
+
+// GetHasNextPage returns ListPublicationPostsPublicationPostsPublicationPostConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationPostsPublicationPostConnectionPageInfo) GetHasNextPage() *bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns ListPublicationPostsPublicationPostsPublicationPostConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationPostsPublicationPostConnectionPageInfo) GetEndCursor() *string {
+	return v.EndCursor
+}
+
+// ListPublicationPostsPublicationSeriesListSeriesConnection includes the requested fields of the GraphQL type SeriesConnection.
+// The GraphQL type's documentation follows.
+//
+// Connection for Series. Contains a list of edges containing nodes.
+// Each node is a Series.
+// Page info contains information about pagination like hasNextPage and endCursor.
+type ListPublicationPostsPublicationSeriesListSeriesConnection struct {
+	// A list of edges containing Series information
+	Edges []ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdge `json:"edges"`
+}
+
+// GetEdges returns ListPublicationPostsPublicationSeriesListSeriesConnection.Edges, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationSeriesListSeriesConnection) GetEdges() []ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdge {
+	return v.Edges
+}
+
+// ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdge includes the requested fields of the GraphQL type SeriesEdge.
+// The GraphQL type's documentation follows.
+//
+// Contains a Series and a cursor for pagination.
+type ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdge struct {
+	// The node holding the Series information
+	Node ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries `json:"node"`
+}
+
+// GetNode returns ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdge.Node, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdge) GetNode() ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries {
+	return v.Node
+}
+
+// ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries includes the requested fields of the GraphQL type Series.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the series.
+// A series is a collection of posts that are related to each other.
+type ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries struct {
+	ImportSeries `json:"-"`
+}
+
+// GetId returns ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries.Id, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries) GetId() string {
+	return v.ImportSeries.Id
+}
+
+// GetName returns ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries.Name, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries) GetName() string {
+	return v.ImportSeries.Name
+}
+
+// GetSlug returns ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries.Slug, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries) GetSlug() string {
+	return v.ImportSeries.Slug
+}
+
+// GetDescription returns ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries.Description, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries) GetDescription() *ImportSeriesDescriptionContent {
+	return v.ImportSeries.Description
+}
+
+func (v *ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ImportSeries)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Slug string `json:"slug"`
+
+	Description *ImportSeriesDescriptionContent `json:"description"`
+}
+
+func (v *ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries) __premarshalJSON() (*__premarshalListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries, error) {
+	var retval __premarshalListPublicationPostsPublicationSeriesListSeriesConnectionEdgesSeriesEdgeNodeSeries
+
+	retval.Id = v.ImportSeries.Id
+	retval.Name = v.ImportSeries.Name
+	retval.Slug = v.ImportSeries.Slug
+	retval.Description = v.ImportSeries.Description
+	return &retval, nil
+}
+
+// ListPublicationPostsResponse is returned by ListPublicationPosts on success.
+type ListPublicationPostsResponse struct {
+	// Returns the publication with the given ID or host.
+	// User can pass anyone of them.
+	Publication *ListPublicationPostsPublication `json:"publication"`
+}
+
+// GetPublication returns ListPublicationPostsResponse.Publication, and is useful for accessing the field via an interface.
+func (v *ListPublicationPostsResponse) GetPublication() *ListPublicationPostsPublication {
+	return v.Publication
+}
+
+// Contains information about meta tags. Used for SEO purpose.
+type MetaTagsInput struct {
+	// The title of the post used in og:title for SEO.
+	Title *string `json:"title"`
+	// The description of the post used in og:description for SEO.
+	Description *string `json:"description"`
+	// The image URL of the post used in og:image for SEO.
+	Image *string `json:"image"`
+}
+
+// GetTitle returns MetaTagsInput.Title, and is useful for accessing the field via an interface.
+func (v *MetaTagsInput) GetTitle() *string { return v.Title }
+
+// GetDescription returns MetaTagsInput.Description, and is useful for accessing the field via an interface.
+func (v *MetaTagsInput) GetDescription() *string { return v.Description }
+
+// GetImage returns MetaTagsInput.Image, and is useful for accessing the field via an interface.
+func (v *MetaTagsInput) GetImage() *string { return v.Image }
+
+// Connection to get list of posts in publications.
+// Returns a list of edges which contains the posts in publication and cursor to the last item of the previous page.
+type PublicationPostConnectionFilter struct {
+	// Filtering by tag slugs and tag IDs will return posts that match either of the filters.
+	//
+	// It is an "OR" filter and not an "AND" filter.
+	Tags []string `json:"tags"`
+	// Filtering by tag slugs and tag IDs will return posts that match either of the filters.
+	//
+	// It is an "OR" filter and not an "AND" filter.
+	TagSlugs []string `json:"tagSlugs"`
+	// Remove pinned post from the result set.
+	ExcludePinnedPost *bool `json:"excludePinnedPost"`
+	// Only return posts that are deleted. Query returns active posts by default, set this to true to return deleted posts.
+	DeletedOnly *bool `json:"deletedOnly"`
+	// Tags AND filter. All tags must be present in the post.
+	RequiredTags []string `json:"requiredTags"`
+	// Tags AND filter. All tags must be present in the post.
+	RequiredTagSlugs []string `json:"requiredTagSlugs"`
+}
+
+// GetTags returns PublicationPostConnectionFilter.Tags, and is useful for accessing the field via an interface.
+func (v *PublicationPostConnectionFilter) GetTags() []string { return v.Tags }
+
+// GetTagSlugs returns PublicationPostConnectionFilter.TagSlugs, and is useful for accessing the field via an interface.
+func (v *PublicationPostConnectionFilter) GetTagSlugs() []string { return v.TagSlugs }
+
+// GetExcludePinnedPost returns PublicationPostConnectionFilter.ExcludePinnedPost, and is useful for accessing the field via an interface.
+func (v *PublicationPostConnectionFilter) GetExcludePinnedPost() *bool { return v.ExcludePinnedPost }
+
+// GetDeletedOnly returns PublicationPostConnectionFilter.DeletedOnly, and is useful for accessing the field via an interface.
+func (v *PublicationPostConnectionFilter) GetDeletedOnly() *bool { return v.DeletedOnly }
+
+// GetRequiredTags returns PublicationPostConnectionFilter.RequiredTags, and is useful for accessing the field via an interface.
+func (v *PublicationPostConnectionFilter) GetRequiredTags() []string { return v.RequiredTags }
+
+// GetRequiredTagSlugs returns PublicationPostConnectionFilter.RequiredTagSlugs, and is useful for accessing the field via an interface.
+func (v *PublicationPostConnectionFilter) GetRequiredTagSlugs() []string { return v.RequiredTagSlugs }
+
+type PublishDraftInput struct {
+	// The id of the draft that should be published
+	DraftId string `json:"draftId"`
+}
+
+// GetDraftId returns PublishDraftInput.DraftId, and is useful for accessing the field via an interface.
+func (v *PublishDraftInput) GetDraftId() string { return v.DraftId }
+
+// PublishDraftPublishDraftPublishDraftPayload includes the requested fields of the GraphQL type PublishDraftPayload.
+type PublishDraftPublishDraftPublishDraftPayload struct {
+	// The newly created post based on the draft
+	Post *PublishDraftPublishDraftPublishDraftPayloadPost `json:"post"`
+}
+
+// GetPost returns PublishDraftPublishDraftPublishDraftPayload.Post, and is useful for accessing the field via an interface.
+func (v *PublishDraftPublishDraftPublishDraftPayload) GetPost() *PublishDraftPublishDraftPublishDraftPayloadPost {
+	return v.Post
+}
+
+// PublishDraftPublishDraftPublishDraftPayloadPost includes the requested fields of the GraphQL type Post.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the post.
+// A post is a published article on Hashnode.
+type PublishDraftPublishDraftPublishDraftPayloadPost struct {
+	// The ID of the post. Used to uniquely identify the post.
+	Id string `json:"id"`
+	// The slug of the post. Used as address of the post on blog. Example - https://johndoe.com/my-post-slug
+	Slug string `json:"slug"`
+}
+
+// GetId returns PublishDraftPublishDraftPublishDraftPayloadPost.Id, and is useful for accessing the field via an interface.
+func (v *PublishDraftPublishDraftPublishDraftPayloadPost) GetId() string { return v.Id }
+
+// GetSlug returns PublishDraftPublishDraftPublishDraftPayloadPost.Slug, and is useful for accessing the field via an interface.
+func (v *PublishDraftPublishDraftPublishDraftPayloadPost) GetSlug() string { return v.Slug }
+
+// PublishDraftResponse is returned by PublishDraft on success.
+type PublishDraftResponse struct {
+	// Publishes an existing draft as a post.
+	PublishDraft PublishDraftPublishDraftPublishDraftPayload `json:"publishDraft"`
+}
+
+// GetPublishDraft returns PublishDraftResponse.PublishDraft, and is useful for accessing the field via an interface.
+func (v *PublishDraftResponse) GetPublishDraft() PublishDraftPublishDraftPublishDraftPayload {
+	return v.PublishDraft
+}
+
+// Contains information about the post to be published.
+type PublishPostInput struct {
+	// The ID of the draft to be published.
+	// If an ID is provided, all other input fields are ignored. The corresponding draft is used to create the post.
+	DraftId *string `json:"draftId"`
+	// The title of the post.
+	Title string `json:"title"`
+	// The subtitle of the post.
+	Subtitle *string `json:"subtitle"`
+	// The ID of publication the post belongs to.
+	PublicationId string `json:"publicationId"`
+	// Content of the post in markdown format.
+	ContentMarkdown string `json:"contentMarkdown"`
+	// Date when the post is published.
+	PublishedAt *time.Time `json:"publishedAt"`
+	// Options for the cover image of the post.
+	CoverImageOptions *CoverImageOptionsInput `json:"coverImageOptions"`
+	// Options for the banner image of the post.
+	// It is similar to cover image but users can decide to render banner image of single post view.
+	BannerImageOptions *BannerImageOptionsInput `json:"bannerImageOptions"`
+	// Slug of the post.
+	Slug *string `json:"slug"`
+	// The URL of the original article if the post is imported from an external source.
+	OriginalArticleURL *string `json:"originalArticleURL"`
+	// A list of tags to add to the post. You can get a list of popular tags available on Hashnode here.
+	// https://github.com/Hashnode/support/blob/main/misc/tags.json
+	Tags []PublishPostTagInput `json:"tags"`
+	// A flag to indicate if the comments are disabled for the post.
+	DisableComments *bool `json:"disableComments"`
+	// Information about the meta tags added to the post, used for SEO purpose.
+	MetaTags *MetaTagsInput `json:"metaTags"`
+	// Publish the post on behalf of another user who is a member of the publication.
+	//
+	// Only applicable for team publications.
+	PublishAs *string `json:"publishAs"`
+	// Providing a seriesId will add the post to that series.
+	SeriesId *string `json:"seriesId"`
+	// Settings for the post like table of contents and newsletter activation.
+	Settings *PublishPostSettingsInput `json:"settings"`
+	// Ids of the co-authors of the post.
+	CoAuthors []string `json:"coAuthors"`
+}
+
+// GetDraftId returns PublishPostInput.DraftId, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetDraftId() *string { return v.DraftId }
+
+// GetTitle returns PublishPostInput.Title, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetTitle() string { return v.Title }
+
+// GetSubtitle returns PublishPostInput.Subtitle, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetSubtitle() *string { return v.Subtitle }
+
+// GetPublicationId returns PublishPostInput.PublicationId, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetPublicationId() string { return v.PublicationId }
+
+// GetContentMarkdown returns PublishPostInput.ContentMarkdown, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetContentMarkdown() string { return v.ContentMarkdown }
+
+// GetPublishedAt returns PublishPostInput.PublishedAt, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetPublishedAt() *time.Time { return v.PublishedAt }
+
+// GetCoverImageOptions returns PublishPostInput.CoverImageOptions, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetCoverImageOptions() *CoverImageOptionsInput { return v.CoverImageOptions }
+
+// GetBannerImageOptions returns PublishPostInput.BannerImageOptions, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetBannerImageOptions() *BannerImageOptionsInput {
+	return v.BannerImageOptions
+}
+
+// GetSlug returns PublishPostInput.Slug, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetSlug() *string { return v.Slug }
+
+// GetOriginalArticleURL returns PublishPostInput.OriginalArticleURL, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetOriginalArticleURL() *string { return v.OriginalArticleURL }
+
+// GetTags returns PublishPostInput.Tags, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetTags() []PublishPostTagInput { return v.Tags }
+
+// GetDisableComments returns PublishPostInput.DisableComments, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetDisableComments() *bool { return v.DisableComments }
+
+// GetMetaTags returns PublishPostInput.MetaTags, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetMetaTags() *MetaTagsInput { return v.MetaTags }
+
+// GetPublishAs returns PublishPostInput.PublishAs, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetPublishAs() *string { return v.PublishAs }
+
+// GetSeriesId returns PublishPostInput.SeriesId, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetSeriesId() *string { return v.SeriesId }
+
+// GetSettings returns PublishPostInput.Settings, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetSettings() *PublishPostSettingsInput { return v.Settings }
+
+// GetCoAuthors returns PublishPostInput.CoAuthors, and is useful for accessing the field via an interface.
+func (v *PublishPostInput) GetCoAuthors() []string { return v.CoAuthors }
+
+// PublishPostPublishPostPublishPostPayload includes the requested fields of the GraphQL type PublishPostPayload.
+type PublishPostPublishPostPublishPostPayload struct {
+	Post *PublishPostPublishPostPublishPostPayloadPost `json:"post"`
+}
+
+// GetPost returns PublishPostPublishPostPublishPostPayload.Post, and is useful for accessing the field via an interface.
+func (v *PublishPostPublishPostPublishPostPayload) GetPost() *PublishPostPublishPostPublishPostPayloadPost {
+	return v.Post
+}
+
+// PublishPostPublishPostPublishPostPayloadPost includes the requested fields of the GraphQL type Post.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the post.
+// A post is a published article on Hashnode.
+type PublishPostPublishPostPublishPostPayloadPost struct {
+	// The ID of the post. Used to uniquely identify the post.
+	Id string `json:"id"`
+	// The slug of the post. Used as address of the post on blog. Example - https://johndoe.com/my-post-slug
+	Slug string `json:"slug"`
+	// Complete URL of the post including the domain name. Example - https://johndoe.com/my-post-slug
+	Url string `json:"url"`
+	// The date and time the post was published.
+	PublishedAt time.Time `json:"publishedAt"`
+	// The date and time the post was last updated.
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+// GetId returns PublishPostPublishPostPublishPostPayloadPost.Id, and is useful for accessing the field via an interface.
+func (v *PublishPostPublishPostPublishPostPayloadPost) GetId() string { return v.Id }
+
+// GetSlug returns PublishPostPublishPostPublishPostPayloadPost.Slug, and is useful for accessing the field via an interface.
+func (v *PublishPostPublishPostPublishPostPayloadPost) GetSlug() string { return v.Slug }
+
+// GetUrl returns PublishPostPublishPostPublishPostPayloadPost.Url, and is useful for accessing the field via an interface.
+func (v *PublishPostPublishPostPublishPostPayloadPost) GetUrl() string { return v.Url }
+
+// GetPublishedAt returns PublishPostPublishPostPublishPostPayloadPost.PublishedAt, and is useful for accessing the field via an interface.
+func (v *PublishPostPublishPostPublishPostPayloadPost) GetPublishedAt() time.Time {
+	return v.PublishedAt
+}
+
+// GetUpdatedAt returns PublishPostPublishPostPublishPostPayloadPost.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *PublishPostPublishPostPublishPostPayloadPost) GetUpdatedAt() *time.Time { return v.UpdatedAt }
+
+// PublishPostResponse is returned by PublishPost on success.
+type PublishPostResponse struct {
+	// Creates a new post.
+	PublishPost PublishPostPublishPostPublishPostPayload `json:"publishPost"`
+}
+
+// GetPublishPost returns PublishPostResponse.PublishPost, and is useful for accessing the field via an interface.
+func (v *PublishPostResponse) GetPublishPost() PublishPostPublishPostPublishPostPayload {
+	return v.PublishPost
+}
+
+type PublishPostSettingsInput struct {
+	// A flag to indicate if the post is scheduled.
+	Scheduled *bool `json:"scheduled"`
+	// A flag to indicate if the post contains table of content
+	EnableTableOfContent *bool `json:"enableTableOfContent"`
+	// Flag to indicate if the slug is overridden by the user.
+	SlugOverridden *bool `json:"slugOverridden"`
+	// Whether to send a newsletter for this post.
+	IsNewsletterActivated *bool `json:"isNewsletterActivated"`
+	// A flag to indicate if the post is delisted, used to hide the post from public feed.
+	Delisted *bool `json:"delisted"`
+}
+
+// GetScheduled returns PublishPostSettingsInput.Scheduled, and is useful for accessing the field via an interface.
+func (v *PublishPostSettingsInput) GetScheduled() *bool { return v.Scheduled }
+
+// GetEnableTableOfContent returns PublishPostSettingsInput.EnableTableOfContent, and is useful for accessing the field via an interface.
+func (v *PublishPostSettingsInput) GetEnableTableOfContent() *bool { return v.EnableTableOfContent }
+
+// GetSlugOverridden returns PublishPostSettingsInput.SlugOverridden, and is useful for accessing the field via an interface.
+func (v *PublishPostSettingsInput) GetSlugOverridden() *bool { return v.SlugOverridden }
+
+// GetIsNewsletterActivated returns PublishPostSettingsInput.IsNewsletterActivated, and is useful for accessing the field via an interface.
+func (v *PublishPostSettingsInput) GetIsNewsletterActivated() *bool { return v.IsNewsletterActivated }
+
+// GetDelisted returns PublishPostSettingsInput.Delisted, and is useful for accessing the field via an interface.
+func (v *PublishPostSettingsInput) GetDelisted() *bool { return v.Delisted }
+
+type PublishPostTagInput struct {
+	// A tag id that is referencing an existing tag.
+	//
+	// Either this or name and slug should be provided. If both are provided, the id will be used.
+	Id *string `json:"id"`
+	// A slug of a new tag to create.
+	//
+	// Either this and name or id should be provided. If both are provided, the id will be used.
+	Slug *string `json:"slug"`
+	// A name of a new tag to create.
+	//
+	// Either this and slug or id should be provided. If both are provided, the id will be used.
+	Name *string `json:"name"`
+}
+
+// GetId returns PublishPostTagInput.Id, and is useful for accessing the field via an interface.
+func (v *PublishPostTagInput) GetId() *string { return v.Id }
+
+// GetSlug returns PublishPostTagInput.Slug, and is useful for accessing the field via an interface.
+func (v *PublishPostTagInput) GetSlug() *string { return v.Slug }
+
+// GetName returns PublishPostTagInput.Name, and is useful for accessing the field via an interface.
+func (v *PublishPostTagInput) GetName() *string { return v.Name }
+
+type RemovePostInput struct {
+	// The ID of the post to remove.
+	Id string `json:"id"`
+}
+
+// GetId returns RemovePostInput.Id, and is useful for accessing the field via an interface.
+func (v *RemovePostInput) GetId() string { return v.Id }
+
+// RemovePostRemovePostRemovePostPayload includes the requested fields of the GraphQL type RemovePostPayload.
+type RemovePostRemovePostRemovePostPayload struct {
+	// The deleted post.
+	Post *RemovePostRemovePostRemovePostPayloadPost `json:"post"`
+}
+
+// GetPost returns RemovePostRemovePostRemovePostPayload.Post, and is useful for accessing the field via an interface.
+func (v *RemovePostRemovePostRemovePostPayload) GetPost() *RemovePostRemovePostRemovePostPayloadPost {
+	return v.Post
+}
+
+// RemovePostRemovePostRemovePostPayloadPost includes the requested fields of the GraphQL type Post.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the post.
+// A post is a published article on Hashnode.
+type RemovePostRemovePostRemovePostPayloadPost struct {
+	// The ID of the post. Used to uniquely identify the post.
+	Id string `json:"id"`
+}
+
+// GetId returns RemovePostRemovePostRemovePostPayloadPost.Id, and is useful for accessing the field via an interface.
+func (v *RemovePostRemovePostRemovePostPayloadPost) GetId() string { return v.Id }
+
+// RemovePostResponse is returned by RemovePost on success.
+type RemovePostResponse struct {
+	// Removes a post.
+	RemovePost RemovePostRemovePostRemovePostPayload `json:"removePost"`
+}
+
+// GetRemovePost returns RemovePostResponse.RemovePost, and is useful for accessing the field via an interface.
+func (v *RemovePostResponse) GetRemovePost() RemovePostRemovePostRemovePostPayload {
+	return v.RemovePost
+}
+
+type RemoveRedirectionRuleInput struct {
+	Id            string `json:"id"`
+	PublicationId string `json:"publicationId"`
+}
+
+// GetId returns RemoveRedirectionRuleInput.Id, and is useful for accessing the field via an interface.
+func (v *RemoveRedirectionRuleInput) GetId() string { return v.Id }
+
+// GetPublicationId returns RemoveRedirectionRuleInput.PublicationId, and is useful for accessing the field via an interface.
+func (v *RemoveRedirectionRuleInput) GetPublicationId() string { return v.PublicationId }
+
+// RemoveRedirectionRuleRemoveRedirectionRuleRemoveRedirectionRulePayload includes the requested fields of the GraphQL type RemoveRedirectionRulePayload.
+type RemoveRedirectionRuleRemoveRedirectionRuleRemoveRedirectionRulePayload struct {
+	RedirectionRule RemoveRedirectionRuleRemoveRedirectionRuleRemoveRedirectionRulePayloadRedirectionRule `json:"redirectionRule"`
+}
+
+// GetRedirectionRule returns RemoveRedirectionRuleRemoveRedirectionRuleRemoveRedirectionRulePayload.RedirectionRule, and is useful for accessing the field via an interface.
+func (v *RemoveRedirectionRuleRemoveRedirectionRuleRemoveRedirectionRulePayload) GetRedirectionRule() RemoveRedirectionRuleRemoveRedirectionRuleRemoveRedirectionRulePayloadRedirectionRule {
+	return v.RedirectionRule
+}
+
+// RemoveRedirectionRuleRemoveRedirectionRuleRemoveRedirectionRulePayloadRedirectionRule includes the requested fields of the GraphQL type RedirectionRule.
+type RemoveRedirectionRuleRemoveRedirectionRuleRemoveRedirectionRulePayloadRedirectionRule struct {
+	Id string `json:"id"`
+}
+
+// GetId returns RemoveRedirectionRuleRemoveRedirectionRuleRemoveRedirectionRulePayloadRedirectionRule.Id, and is useful for accessing the field via an interface.
+func (v *RemoveRedirectionRuleRemoveRedirectionRuleRemoveRedirectionRulePayloadRedirectionRule) GetId() string {
+	return v.Id
+}
+
+// RemoveRedirectionRuleResponse is returned by RemoveRedirectionRule on success.
+type RemoveRedirectionRuleResponse struct {
+	RemoveRedirectionRule RemoveRedirectionRuleRemoveRedirectionRuleRemoveRedirectionRulePayload `json:"removeRedirectionRule"`
+}
+
+// GetRemoveRedirectionRule returns RemoveRedirectionRuleResponse.RemoveRedirectionRule, and is useful for accessing the field via an interface.
+func (v *RemoveRedirectionRuleResponse) GetRemoveRedirectionRule() RemoveRedirectionRuleRemoveRedirectionRuleRemoveRedirectionRulePayload {
+	return v.RemoveRedirectionRule
+}
+
+// SortOrder is a common enum for all types that can be sorted.
+type SortOrder string
+
+const (
+	SortOrderAsc SortOrder = "asc"
+	SortOrderDsc SortOrder = "dsc"
+)
+
+var AllSortOrder = []SortOrder{
+	SortOrderAsc,
+	SortOrderDsc,
+}
+
+type TriggerWebhookTestInput struct {
+	WebhookId string `json:"webhookId"`
+}
+
+// GetWebhookId returns TriggerWebhookTestInput.WebhookId, and is useful for accessing the field via an interface.
+func (v *TriggerWebhookTestInput) GetWebhookId() string { return v.WebhookId }
+
+// TriggerWebhookTestResponse is returned by TriggerWebhookTest on success.
+type TriggerWebhookTestResponse struct {
+	TriggerWebhookTest TriggerWebhookTestTriggerWebhookTestTriggerWebhookTestPayload `json:"triggerWebhookTest"`
+}
+
+// GetTriggerWebhookTest returns TriggerWebhookTestResponse.TriggerWebhookTest, and is useful for accessing the field via an interface.
+func (v *TriggerWebhookTestResponse) GetTriggerWebhookTest() TriggerWebhookTestTriggerWebhookTestTriggerWebhookTestPayload {
+	return v.TriggerWebhookTest
+}
+
+// TriggerWebhookTestTriggerWebhookTestTriggerWebhookTestPayload includes the requested fields of the GraphQL type TriggerWebhookTestPayload.
+type TriggerWebhookTestTriggerWebhookTestTriggerWebhookTestPayload struct {
+	Webhook *TriggerWebhookTestTriggerWebhookTestTriggerWebhookTestPayloadWebhook `json:"webhook"`
+}
+
+// GetWebhook returns TriggerWebhookTestTriggerWebhookTestTriggerWebhookTestPayload.Webhook, and is useful for accessing the field via an interface.
+func (v *TriggerWebhookTestTriggerWebhookTestTriggerWebhookTestPayload) GetWebhook() *TriggerWebhookTestTriggerWebhookTestTriggerWebhookTestPayloadWebhook {
+	return v.Webhook
+}
+
+// TriggerWebhookTestTriggerWebhookTestTriggerWebhookTestPayloadWebhook includes the requested fields of the GraphQL type Webhook.
+type TriggerWebhookTestTriggerWebhookTestTriggerWebhookTestPayloadWebhook struct {
+	// The ID of the post. Used to uniquely identify the post.
+	Id  string `json:"id"`
+	Url string `json:"url"`
+}
+
+// GetId returns TriggerWebhookTestTriggerWebhookTestTriggerWebhookTestPayloadWebhook.Id, and is useful for accessing the field via an interface.
+func (v *TriggerWebhookTestTriggerWebhookTestTriggerWebhookTestPayloadWebhook) GetId() string {
+	return v.Id
+}
+
+// GetUrl returns TriggerWebhookTestTriggerWebhookTestTriggerWebhookTestPayloadWebhook.Url, and is useful for accessing the field via an interface.
+func (v *TriggerWebhookTestTriggerWebhookTestTriggerWebhookTestPayloadWebhook) GetUrl() string {
+	return v.Url
+}
+
+type UpdatePostInput struct {
+	// The id of the post to update.
+	Id string `json:"id"`
+	// The new title of the post
+	Title *string `json:"title"`
+	// The subtitle of the post
+	Subtitle *string `json:"subtitle"`
+	// The publication the post is published to.
+	ContentMarkdown *string `json:"contentMarkdown"`
+	// Backdated publish date.
+	PublishedAt *time.Time `json:"publishedAt"`
+	// Options for the cover image of the post.
+	CoverImageOptions *CoverImageOptionsInput `json:"coverImageOptions"`
+	// Options for the banner image of the post.
+	BannerImageOptions *BannerImageOptionsInput `json:"bannerImageOptions"`
+	// Slug of the post. Only if you want to override the slug that will be generated based on the title.
+	Slug *string `json:"slug"`
+	// Canonical URL of the original article.
+	OriginalArticleURL *string `json:"originalArticleURL"`
+	// Tags to add to the post. New tags will be created if they don't exist. It overrides the existing tags.
+	Tags []PublishPostTagInput `json:"tags"`
+	// Information about the meta tags added to the post, used for SEO purpose.
+	MetaTags *MetaTagsInput `json:"metaTags"`
+	// Set a different author for the post than the requesting user.
+	// Must be a member of the publication.
+	PublishAs *string `json:"publishAs"`
+	// Update co-authors of the post.
+	// Must be a member of the publication.
+	CoAuthors []string `json:"coAuthors"`
+	// Providing a seriesId will add the post to that series.
+	// Must be a series of the publication.
+	SeriesId *string `json:"seriesId"`
+	// Whether or not to enable the table of content.
+	Settings *UpdatePostSettingsInput `json:"settings"`
+	// If the publication should be changed this is the new Publication ID
+	PublicationId *string `json:"publicationId"`
+}
+
+// GetId returns UpdatePostInput.Id, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetId() string { return v.Id }
+
+// GetTitle returns UpdatePostInput.Title, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetTitle() *string { return v.Title }
+
+// GetSubtitle returns UpdatePostInput.Subtitle, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetSubtitle() *string { return v.Subtitle }
+
+// GetContentMarkdown returns UpdatePostInput.ContentMarkdown, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetContentMarkdown() *string { return v.ContentMarkdown }
+
+// GetPublishedAt returns UpdatePostInput.PublishedAt, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetPublishedAt() *time.Time { return v.PublishedAt }
+
+// GetCoverImageOptions returns UpdatePostInput.CoverImageOptions, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetCoverImageOptions() *CoverImageOptionsInput { return v.CoverImageOptions }
+
+// GetBannerImageOptions returns UpdatePostInput.BannerImageOptions, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetBannerImageOptions() *BannerImageOptionsInput {
+	return v.BannerImageOptions
+}
+
+// GetSlug returns UpdatePostInput.Slug, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetSlug() *string { return v.Slug }
+
+// GetOriginalArticleURL returns UpdatePostInput.OriginalArticleURL, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetOriginalArticleURL() *string { return v.OriginalArticleURL }
+
+// GetTags returns UpdatePostInput.Tags, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetTags() []PublishPostTagInput { return v.Tags }
+
+// GetMetaTags returns UpdatePostInput.MetaTags, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetMetaTags() *MetaTagsInput { return v.MetaTags }
+
+// GetPublishAs returns UpdatePostInput.PublishAs, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetPublishAs() *string { return v.PublishAs }
+
+// GetCoAuthors returns UpdatePostInput.CoAuthors, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetCoAuthors() []string { return v.CoAuthors }
+
+// GetSeriesId returns UpdatePostInput.SeriesId, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetSeriesId() *string { return v.SeriesId }
+
+// GetSettings returns UpdatePostInput.Settings, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetSettings() *UpdatePostSettingsInput { return v.Settings }
+
+// GetPublicationId returns UpdatePostInput.PublicationId, and is useful for accessing the field via an interface.
+func (v *UpdatePostInput) GetPublicationId() *string { return v.PublicationId }
+
+// UpdatePostResponse is returned by UpdatePost on success.
+type UpdatePostResponse struct {
+	UpdatePost UpdatePostUpdatePostUpdatePostPayload `json:"updatePost"`
+}
+
+// GetUpdatePost returns UpdatePostResponse.UpdatePost, and is useful for accessing the field via an interface.
+func (v *UpdatePostResponse) GetUpdatePost() UpdatePostUpdatePostUpdatePostPayload {
+	return v.UpdatePost
+}
+
+type UpdatePostSettingsInput struct {
+	// A flag to indicate if the post contains table of content
+	IsTableOfContentEnabled *bool `json:"isTableOfContentEnabled"`
+	// A flag to indicate if the post is delisted, used to hide the post from public feed.
+	Delisted *bool `json:"delisted"`
+	// Whether or not comments should be disabled.
+	DisableComments *bool `json:"disableComments"`
+	// Pin the post to the blog homepage.
+	PinToBlog *bool `json:"pinToBlog"`
+}
+
+// GetIsTableOfContentEnabled returns UpdatePostSettingsInput.IsTableOfContentEnabled, and is useful for accessing the field via an interface.
+func (v *UpdatePostSettingsInput) GetIsTableOfContentEnabled() *bool {
+	return v.IsTableOfContentEnabled
+}
+
+// GetDelisted returns UpdatePostSettingsInput.Delisted, and is useful for accessing the field via an interface.
+func (v *UpdatePostSettingsInput) GetDelisted() *bool { return v.Delisted }
+
+// GetDisableComments returns UpdatePostSettingsInput.DisableComments, and is useful for accessing the field via an interface.
+func (v *UpdatePostSettingsInput) GetDisableComments() *bool { return v.DisableComments }
+
+// GetPinToBlog returns UpdatePostSettingsInput.PinToBlog, and is useful for accessing the field via an interface.
+func (v *UpdatePostSettingsInput) GetPinToBlog() *bool { return v.PinToBlog }
+
+// UpdatePostUpdatePostUpdatePostPayload includes the requested fields of the GraphQL type UpdatePostPayload.
+type UpdatePostUpdatePostUpdatePostPayload struct {
+	Post *UpdatePostUpdatePostUpdatePostPayloadPost `json:"post"`
+}
+
+// GetPost returns UpdatePostUpdatePostUpdatePostPayload.Post, and is useful for accessing the field via an interface.
+func (v *UpdatePostUpdatePostUpdatePostPayload) GetPost() *UpdatePostUpdatePostUpdatePostPayloadPost {
+	return v.Post
+}
+
+// UpdatePostUpdatePostUpdatePostPayloadPost includes the requested fields of the GraphQL type Post.
+// The GraphQL type's documentation follows.
+//
+// Contains basic information about the post.
+// A post is a published article on Hashnode.
+type UpdatePostUpdatePostUpdatePostPayloadPost struct {
+	// The ID of the post. Used to uniquely identify the post.
+	Id string `json:"id"`
+	// The slug of the post. Used as address of the post on blog. Example - https://johndoe.com/my-post-slug
+	Slug string `json:"slug"`
+	// The date and time the post was last updated.
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+// GetId returns UpdatePostUpdatePostUpdatePostPayloadPost.Id, and is useful for accessing the field via an interface.
+func (v *UpdatePostUpdatePostUpdatePostPayloadPost) GetId() string { return v.Id }
+
+// GetSlug returns UpdatePostUpdatePostUpdatePostPayloadPost.Slug, and is useful for accessing the field via an interface.
+func (v *UpdatePostUpdatePostUpdatePostPayloadPost) GetSlug() string { return v.Slug }
+
+// GetUpdatedAt returns UpdatePostUpdatePostUpdatePostPayloadPost.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *UpdatePostUpdatePostUpdatePostPayloadPost) GetUpdatedAt() *time.Time { return v.UpdatedAt }
+
+type UpdateRedirectionRuleInput struct {
+	Id            string               `json:"id"`
+	PublicationId string               `json:"publicationId"`
+	Source        *string              `json:"source"`
+	Destination   *string              `json:"destination"`
+	Type          *HttpRedirectionType `json:"type"`
+}
+
+// GetId returns UpdateRedirectionRuleInput.Id, and is useful for accessing the field via an interface.
+func (v *UpdateRedirectionRuleInput) GetId() string { return v.Id }
+
+// GetPublicationId returns UpdateRedirectionRuleInput.PublicationId, and is useful for accessing the field via an interface.
+func (v *UpdateRedirectionRuleInput) GetPublicationId() string { return v.PublicationId }
+
+// GetSource returns UpdateRedirectionRuleInput.Source, and is useful for accessing the field via an interface.
+func (v *UpdateRedirectionRuleInput) GetSource() *string { return v.Source }
+
+// GetDestination returns UpdateRedirectionRuleInput.Destination, and is useful for accessing the field via an interface.
+func (v *UpdateRedirectionRuleInput) GetDestination() *string { return v.Destination }
+
+// GetType returns UpdateRedirectionRuleInput.Type, and is useful for accessing the field via an interface.
+func (v *UpdateRedirectionRuleInput) GetType() *HttpRedirectionType { return v.Type }
+
+// UpdateRedirectionRuleResponse is returned by UpdateRedirectionRule on success.
+type UpdateRedirectionRuleResponse struct {
+	UpdateRedirectionRule UpdateRedirectionRuleUpdateRedirectionRuleUpdateRedirectionRulePayload `json:"updateRedirectionRule"`
+}
+
+// GetUpdateRedirectionRule returns UpdateRedirectionRuleResponse.UpdateRedirectionRule, and is useful for accessing the field via an interface.
+func (v *UpdateRedirectionRuleResponse) GetUpdateRedirectionRule() UpdateRedirectionRuleUpdateRedirectionRuleUpdateRedirectionRulePayload {
+	return v.UpdateRedirectionRule
+}
+
+// UpdateRedirectionRuleUpdateRedirectionRuleUpdateRedirectionRulePayload includes the requested fields of the GraphQL type UpdateRedirectionRulePayload.
+type UpdateRedirectionRuleUpdateRedirectionRuleUpdateRedirectionRulePayload struct {
+	RedirectionRule UpdateRedirectionRuleUpdateRedirectionRuleUpdateRedirectionRulePayloadRedirectionRule `json:"redirectionRule"`
+}
+
+// GetRedirectionRule returns UpdateRedirectionRuleUpdateRedirectionRuleUpdateRedirectionRulePayload.RedirectionRule, and is useful for accessing the field via an interface.
+func (v *UpdateRedirectionRuleUpdateRedirectionRuleUpdateRedirectionRulePayload) GetRedirectionRule() UpdateRedirectionRuleUpdateRedirectionRuleUpdateRedirectionRulePayloadRedirectionRule {
+	return v.RedirectionRule
+}
+
+// UpdateRedirectionRuleUpdateRedirectionRuleUpdateRedirectionRulePayloadRedirectionRule includes the requested fields of the GraphQL type RedirectionRule.
+type UpdateRedirectionRuleUpdateRedirectionRuleUpdateRedirectionRulePayloadRedirectionRule struct {
+	Id string `json:"id"`
+}
+
+// GetId returns UpdateRedirectionRuleUpdateRedirectionRuleUpdateRedirectionRulePayloadRedirectionRule.Id, and is useful for accessing the field via an interface.
+func (v *UpdateRedirectionRuleUpdateRedirectionRuleUpdateRedirectionRulePayloadRedirectionRule) GetId() string {
+	return v.Id
+}
+
+type UpdateWebhookInput struct {
+	Id     string         `json:"id"`
+	Url    *string        `json:"url"`
+	Events []WebhookEvent `json:"events"`
+	Secret *string        `json:"secret"`
+}
+
+// GetId returns UpdateWebhookInput.Id, and is useful for accessing the field via an interface.
+func (v *UpdateWebhookInput) GetId() string { return v.Id }
+
+// GetUrl returns UpdateWebhookInput.Url, and is useful for accessing the field via an interface.
+func (v *UpdateWebhookInput) GetUrl() *string { return v.Url }
+
+// GetEvents returns UpdateWebhookInput.Events, and is useful for accessing the field via an interface.
+func (v *UpdateWebhookInput) GetEvents() []WebhookEvent { return v.Events }
+
+// GetSecret returns UpdateWebhookInput.Secret, and is useful for accessing the field via an interface.
+func (v *UpdateWebhookInput) GetSecret() *string { return v.Secret }
+
+// UpdateWebhookResponse is returned by UpdateWebhook on success.
+type UpdateWebhookResponse struct {
+	UpdateWebhook UpdateWebhookUpdateWebhookUpdateWebhookPayload `json:"updateWebhook"`
+}
+
+// GetUpdateWebhook returns UpdateWebhookResponse.UpdateWebhook, and is useful for accessing the field via an interface.
+func (v *UpdateWebhookResponse) GetUpdateWebhook() UpdateWebhookUpdateWebhookUpdateWebhookPayload {
+	return v.UpdateWebhook
+}
+
+// UpdateWebhookUpdateWebhookUpdateWebhookPayload includes the requested fields of the GraphQL type UpdateWebhookPayload.
+type UpdateWebhookUpdateWebhookUpdateWebhookPayload struct {
+	Webhook *UpdateWebhookUpdateWebhookUpdateWebhookPayloadWebhook `json:"webhook"`
+}
+
+// GetWebhook returns UpdateWebhookUpdateWebhookUpdateWebhookPayload.Webhook, and is useful for accessing the field via an interface.
+func (v *UpdateWebhookUpdateWebhookUpdateWebhookPayload) GetWebhook() *UpdateWebhookUpdateWebhookUpdateWebhookPayloadWebhook {
+	return v.Webhook
+}
+
+// UpdateWebhookUpdateWebhookUpdateWebhookPayloadWebhook includes the requested fields of the GraphQL type Webhook.
+type UpdateWebhookUpdateWebhookUpdateWebhookPayloadWebhook struct {
+	// The ID of the post. Used to uniquely identify the post.
+	Id string `json:"id"`
+}
+
+// GetId returns UpdateWebhookUpdateWebhookUpdateWebhookPayloadWebhook.Id, and is useful for accessing the field via an interface.
+func (v *UpdateWebhookUpdateWebhookUpdateWebhookPayloadWebhook) GetId() string { return v.Id }
+
+type WebhookEvent string
+
+const (
+	WebhookEventPostPublished       WebhookEvent = "POST_PUBLISHED"
+	WebhookEventPostUpdated         WebhookEvent = "POST_UPDATED"
+	WebhookEventPostDeleted         WebhookEvent = "POST_DELETED"
+	WebhookEventStaticPagePublished WebhookEvent = "STATIC_PAGE_PUBLISHED"
+	WebhookEventStaticPageEdited    WebhookEvent = "STATIC_PAGE_EDITED"
+	WebhookEventStaticPageDeleted   WebhookEvent = "STATIC_PAGE_DELETED"
+)
+
+var AllWebhookEvent = []WebhookEvent{
+	WebhookEventPostPublished,
+	WebhookEventPostUpdated,
+	WebhookEventPostDeleted,
+	WebhookEventStaticPagePublished,
+	WebhookEventStaticPageEdited,
+	WebhookEventStaticPageDeleted,
+}
+
+// __CreateRedirectionRuleInput is used internally by genqlient
+type __CreateRedirectionRuleInput struct {
+	Input CreateRedirectionRuleInput `json:"input"`
+}
+
+// GetInput returns __CreateRedirectionRuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateRedirectionRuleInput) GetInput() CreateRedirectionRuleInput { return v.Input }
+
+// __CreateSeriesInput is used internally by genqlient
+type __CreateSeriesInput struct {
+	Input CreateSeriesInput `json:"input"`
+}
+
+// GetInput returns __CreateSeriesInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateSeriesInput) GetInput() CreateSeriesInput { return v.Input }
+
+// __CreateWebhookInput is used internally by genqlient
+type __CreateWebhookInput struct {
+	Input CreateWebhookInput `json:"input"`
+}
+
+// GetInput returns __CreateWebhookInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateWebhookInput) GetInput() CreateWebhookInput { return v.Input }
+
+// __DeleteWebhookInput is used internally by genqlient
+type __DeleteWebhookInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __DeleteWebhookInput.Id, and is useful for accessing the field via an interface.
+func (v *__DeleteWebhookInput) GetId() string { return v.Id }
+
+// __GetImportPostInput is used internally by genqlient
+type __GetImportPostInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __GetImportPostInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetImportPostInput) GetId() string { return v.Id }
+
+// __GetPostInput is used internally by genqlient
+type __GetPostInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __GetPostInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetPostInput) GetId() string { return v.Id }
+
+// __GetPublicationDataInput is used internally by genqlient
+type __GetPublicationDataInput struct {
+	Id     string                           `json:"id"`
+	First  int                              `json:"first"`
+	After  *string                          `json:"after"`
+	Filter *PublicationPostConnectionFilter `json:"filter"`
+}
+
+// GetId returns __GetPublicationDataInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetPublicationDataInput) GetId() string { return v.Id }
+
+// GetFirst returns __GetPublicationDataInput.First, and is useful for accessing the field via an interface.
+func (v *__GetPublicationDataInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetPublicationDataInput.After, and is useful for accessing the field via an interface.
+func (v *__GetPublicationDataInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __GetPublicationDataInput.Filter, and is useful for accessing the field via an interface.
+func (v *__GetPublicationDataInput) GetFilter() *PublicationPostConnectionFilter { return v.Filter }
+
+// __GetPublicationDraftsInput is used internally by genqlient
+type __GetPublicationDraftsInput struct {
+	Id    string  `json:"id"`
+	First int     `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetId returns __GetPublicationDraftsInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetPublicationDraftsInput) GetId() string { return v.Id }
+
+// GetFirst returns __GetPublicationDraftsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetPublicationDraftsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetPublicationDraftsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetPublicationDraftsInput) GetAfter() *string { return v.After }
+
+// __GetPublicationScheduledDraftsInput is used internally by genqlient
+type __GetPublicationScheduledDraftsInput struct {
+	Id    string  `json:"id"`
+	First int     `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetId returns __GetPublicationScheduledDraftsInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetPublicationScheduledDraftsInput) GetId() string { return v.Id }
+
+// GetFirst returns __GetPublicationScheduledDraftsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetPublicationScheduledDraftsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetPublicationScheduledDraftsInput.After, and is useful for accessing the field via an interface.
+func (v *__GetPublicationScheduledDraftsInput) GetAfter() *string { return v.After }
+
+// __GetRedirectionRulesInput is used internally by genqlient
+type __GetRedirectionRulesInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __GetRedirectionRulesInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetRedirectionRulesInput) GetId() string { return v.Id }
+
+// __GetStaticPagesInput is used internally by genqlient
+type __GetStaticPagesInput struct {
+	Id    string  `json:"id"`
+	First int     `json:"first"`
+	After *string `json:"after"`
+}
+
+// GetId returns __GetStaticPagesInput.Id, and is useful for accessing the field via an interface.
+func (v *__GetStaticPagesInput) GetId() string { return v.Id }
+
+// GetFirst returns __GetStaticPagesInput.First, and is useful for accessing the field via an interface.
+func (v *__GetStaticPagesInput) GetFirst() int { return v.First }
+
+// GetAfter returns __GetStaticPagesInput.After, and is useful for accessing the field via an interface.
+func (v *__GetStaticPagesInput) GetAfter() *string { return v.After }
+
+// __ListPublicationPostsInput is used internally by genqlient
+type __ListPublicationPostsInput struct {
+	Id     string                           `json:"id"`
+	First  int                              `json:"first"`
+	After  *string                          `json:"after"`
+	Filter *PublicationPostConnectionFilter `json:"filter"`
+}
+
+// GetId returns __ListPublicationPostsInput.Id, and is useful for accessing the field via an interface.
+func (v *__ListPublicationPostsInput) GetId() string { return v.Id }
+
+// GetFirst returns __ListPublicationPostsInput.First, and is useful for accessing the field via an interface.
+func (v *__ListPublicationPostsInput) GetFirst() int { return v.First }
+
+// GetAfter returns __ListPublicationPostsInput.After, and is useful for accessing the field via an interface.
+func (v *__ListPublicationPostsInput) GetAfter() *string { return v.After }
+
+// GetFilter returns __ListPublicationPostsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__ListPublicationPostsInput) GetFilter() *PublicationPostConnectionFilter { return v.Filter }
+
+// __PublishDraftInput is used internally by genqlient
+type __PublishDraftInput struct {
+	Input PublishDraftInput `json:"input"`
+}
+
+// GetInput returns __PublishDraftInput.Input, and is useful for accessing the field via an interface.
+func (v *__PublishDraftInput) GetInput() PublishDraftInput { return v.Input }
+
+// __PublishPostInput is used internally by genqlient
 type __PublishPostInput struct {
 	Input PublishPostInput `json:"input"`
 }
 
-// GetInput returns __PublishPostInput.Input, and is useful for accessing the field via an interface.
-func (v *__PublishPostInput) GetInput() PublishPostInput { return v.Input }
+// GetInput returns __PublishPostInput.Input, and is useful for accessing the field via an interface.
+func (v *__PublishPostInput) GetInput() PublishPostInput { return v.Input }
+
+// __RemovePostInput is used internally by genqlient
+type __RemovePostInput struct {
+	Input RemovePostInput `json:"input"`
+}
+
+// GetInput returns __RemovePostInput.Input, and is useful for accessing the field via an interface.
+func (v *__RemovePostInput) GetInput() RemovePostInput { return v.Input }
+
+// __RemoveRedirectionRuleInput is used internally by genqlient
+type __RemoveRedirectionRuleInput struct {
+	Input RemoveRedirectionRuleInput `json:"input"`
+}
+
+// GetInput returns __RemoveRedirectionRuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__RemoveRedirectionRuleInput) GetInput() RemoveRedirectionRuleInput { return v.Input }
+
+// __TriggerWebhookTestInput is used internally by genqlient
+type __TriggerWebhookTestInput struct {
+	Input TriggerWebhookTestInput `json:"input"`
+}
+
+// GetInput returns __TriggerWebhookTestInput.Input, and is useful for accessing the field via an interface.
+func (v *__TriggerWebhookTestInput) GetInput() TriggerWebhookTestInput { return v.Input }
+
+// __UpdatePostInput is used internally by genqlient
+type __UpdatePostInput struct {
+	Input UpdatePostInput `json:"input"`
+}
+
+// GetInput returns __UpdatePostInput.Input, and is useful for accessing the field via an interface.
+func (v *__UpdatePostInput) GetInput() UpdatePostInput { return v.Input }
+
+// __UpdateRedirectionRuleInput is used internally by genqlient
+type __UpdateRedirectionRuleInput struct {
+	Input UpdateRedirectionRuleInput `json:"input"`
+}
+
+// GetInput returns __UpdateRedirectionRuleInput.Input, and is useful for accessing the field via an interface.
+func (v *__UpdateRedirectionRuleInput) GetInput() UpdateRedirectionRuleInput { return v.Input }
+
+// __UpdateWebhookInput is used internally by genqlient
+type __UpdateWebhookInput struct {
+	Input UpdateWebhookInput `json:"input"`
+}
+
+// GetInput returns __UpdateWebhookInput.Input, and is useful for accessing the field via an interface.
+func (v *__UpdateWebhookInput) GetInput() UpdateWebhookInput { return v.Input }
+
+// The mutation executed by CreateRedirectionRule.
+const CreateRedirectionRule_Operation = `
+mutation CreateRedirectionRule ($input: CreateRedirectionRuleInput!) {
+	createRedirectionRule(input: $input) {
+		redirectionRule {
+			id
+			type
+			source
+			destination
+		}
+	}
+}
+`
+
+func CreateRedirectionRule(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input CreateRedirectionRuleInput,
+) (data_ *CreateRedirectionRuleResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateRedirectionRule",
+		Query:  CreateRedirectionRule_Operation,
+		Variables: &__CreateRedirectionRuleInput{
+			Input: input,
+		},
+	}
+
+	data_ = &CreateRedirectionRuleResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateSeries.
+const CreateSeries_Operation = `
+mutation CreateSeries ($input: CreateSeriesInput!) {
+	createSeries(input: $input) {
+		series {
+			id
+			name
+			slug
+		}
+	}
+}
+`
+
+// Create a new series if it doesn't exist locally
+func CreateSeries(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input CreateSeriesInput,
+) (data_ *CreateSeriesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateSeries",
+		Query:  CreateSeries_Operation,
+		Variables: &__CreateSeriesInput{
+			Input: input,
+		},
+	}
+
+	data_ = &CreateSeriesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateWebhook.
+const CreateWebhook_Operation = `
+mutation CreateWebhook ($input: CreateWebhookInput!) {
+	createWebhook(input: $input) {
+		webhook {
+			id
+			url
+			events
+		}
+	}
+}
+`
+
+func CreateWebhook(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input CreateWebhookInput,
+) (data_ *CreateWebhookResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateWebhook",
+		Query:  CreateWebhook_Operation,
+		Variables: &__CreateWebhookInput{
+			Input: input,
+		},
+	}
+
+	data_ = &CreateWebhookResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by DeleteWebhook.
+const DeleteWebhook_Operation = `
+mutation DeleteWebhook ($id: ID!) {
+	deleteWebhook(id: $id) {
+		webhook {
+			id
+		}
+	}
+}
+`
+
+func DeleteWebhook(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *DeleteWebhookResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "DeleteWebhook",
+		Query:  DeleteWebhook_Operation,
+		Variables: &__DeleteWebhookInput{
+			Id: id,
+		},
+	}
+
+	data_ = &DeleteWebhookResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetImportPost.
+const GetImportPost_Operation = `
+query GetImportPost ($id: ID!) {
+	post(id: $id) {
+		... ImportPost
+	}
+}
+fragment ImportPost on Post {
+	id
+	title
+	slug
+	publishedAt
+	updatedAt
+	content {
+		markdown
+	}
+	tags {
+		name
+		slug
+	}
+	series {
+		id
+		name
+		slug
+	}
+}
+`
+
+func GetImportPost(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *GetImportPostResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetImportPost",
+		Query:  GetImportPost_Operation,
+		Variables: &__GetImportPostInput{
+			Id: id,
+		},
+	}
+
+	data_ = &GetImportPostResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetMe.
+const GetMe_Operation = `
+query GetMe {
+	me {
+		id
+		username
+		publications(first: 5) {
+			edges {
+				node {
+					id
+					title
+					url
+				}
+			}
+		}
+	}
+}
+`
+
+// --- 1. Identity & Discovery (For 'init') ---
+func GetMe(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *GetMeResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetMe",
+		Query:  GetMe_Operation,
+	}
+
+	data_ = &GetMeResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetPost.
+const GetPost_Operation = `
+query GetPost ($id: ID!) {
+	post(id: $id) {
+		id
+		title
+		subtitle
+		slug
+		canonicalUrl
+		tags {
+			name
+			slug
+		}
+		series {
+			name
+			slug
+		}
+		coverImage {
+			url
+		}
+		seo {
+			title
+			description
+		}
+		content {
+			markdown
+		}
+		updatedAt
+	}
+}
+`
+
+func GetPost(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+) (data_ *GetPostResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetPost",
+		Query:  GetPost_Operation,
+		Variables: &__GetPostInput{
+			Id: id,
+		},
+	}
+
+	data_ = &GetPostResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetPublicationData.
+const GetPublicationData_Operation = `
+query GetPublicationData ($id: ObjectId!, $first: Int!, $after: String, $filter: PublicationPostConnectionFilter) {
+	publication(id: $id) {
+		title
+		posts(first: $first, after: $after, filter: $filter) {
+			edges {
+				node {
+					... ImportPost
+					brief
+					coverImage {
+						url
+					}
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+		seriesList(first: 50) {
+			edges {
+				node {
+					... ImportSeries
+				}
+			}
+		}
+	}
+}
+fragment ImportPost on Post {
+	id
+	title
+	slug
+	publishedAt
+	updatedAt
+	content {
+		markdown
+	}
+	tags {
+		name
+		slug
+	}
+	series {
+		id
+		name
+		slug
+	}
+}
+fragment ImportSeries on Series {
+	id
+	name
+	slug
+	description {
+		markdown
+	}
+}
+`
+
+// This query handles both 'plan' (checking diffs) and 'import' (downloading everything)
+func GetPublicationData(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	first int,
+	after *string,
+	filter *PublicationPostConnectionFilter,
+) (data_ *GetPublicationDataResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetPublicationData",
+		Query:  GetPublicationData_Operation,
+		Variables: &__GetPublicationDataInput{
+			Id:     id,
+			First:  first,
+			After:  after,
+			Filter: filter,
+		},
+	}
+
+	data_ = &GetPublicationDataResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetPublicationDrafts.
+const GetPublicationDrafts_Operation = `
+query GetPublicationDrafts ($id: ObjectId!, $first: Int!, $after: String) {
+	publication(id: $id) {
+		drafts(first: $first, after: $after) {
+			edges {
+				node {
+					... ImportDraft
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+fragment ImportDraft on Draft {
+	id
+	slug
+	title
+	updatedAt
+	scheduledDate
+	content {
+		markdown
+	}
+	tagsV2 {
+		__typename
+		... on Tag {
+			slug
+		}
+		... on DraftBaseTag {
+			slug
+		}
+	}
+	series {
+		id
+		name
+		slug
+	}
+}
+`
+
+func GetPublicationDrafts(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	first int,
+	after *string,
+) (data_ *GetPublicationDraftsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetPublicationDrafts",
+		Query:  GetPublicationDrafts_Operation,
+		Variables: &__GetPublicationDraftsInput{
+			Id:    id,
+			First: first,
+			After: after,
+		},
+	}
+
+	data_ = &GetPublicationDraftsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetPublicationScheduledDrafts.
+const GetPublicationScheduledDrafts_Operation = `
+query GetPublicationScheduledDrafts ($id: ObjectId!, $first: Int!, $after: String) {
+	publication(id: $id) {
+		scheduledDrafts(first: $first, after: $after) {
+			edges {
+				node {
+					... ImportDraft
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+fragment ImportDraft on Draft {
+	id
+	slug
+	title
+	updatedAt
+	scheduledDate
+	content {
+		markdown
+	}
+	tagsV2 {
+		__typename
+		... on Tag {
+			slug
+		}
+		... on DraftBaseTag {
+			slug
+		}
+	}
+	series {
+		id
+		name
+		slug
+	}
+}
+`
+
+func GetPublicationScheduledDrafts(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	id string,
+	first int,
+	after *string,
+) (data_ *GetPublicationScheduledDraftsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetPublicationScheduledDrafts",
+		Query:  GetPublicationScheduledDrafts_Operation,
+		Variables: &__GetPublicationScheduledDraftsInput{
+			Id:    id,
+			First: first,
+			After: after,
+		},
+	}
 
-// __RemovePostInput is used internally by genqlient
-type __RemovePostInput struct {
-	Input RemovePostInput `json:"input"`
-}
+	data_ = &GetPublicationScheduledDraftsResponse{}
+	resp_ := &graphql.Response{Data: data_}
 
-// GetInput returns __RemovePostInput.Input, and is useful for accessing the field via an interface.
-func (v *__RemovePostInput) GetInput() RemovePostInput { return v.Input }
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
 
-// __UpdatePostInput is used internally by genqlient
-type __UpdatePostInput struct {
-	Input UpdatePostInput `json:"input"`
+	return data_, err_
 }
 
-// GetInput returns __UpdatePostInput.Input, and is useful for accessing the field via an interface.
-func (v *__UpdatePostInput) GetInput() UpdatePostInput { return v.Input }
-
-// The mutation executed by CreateSeries.
-const CreateSeries_Operation = `
-mutation CreateSeries ($input: CreateSeriesInput!) {
-	createSeries(input: $input) {
-		series {
+// The query executed by GetRedirectionRules.
+const GetRedirectionRules_Operation = `
+query GetRedirectionRules ($id: ObjectId!) {
+	publication(id: $id) {
+		redirectionRules {
 			id
-			name
-			slug
+			type
+			source
+			destination
 		}
 	}
 }
 `
 
-// Create a new series if it doesn't exist locally
-func CreateSeries(
+func GetRedirectionRules(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	input CreateSeriesInput,
-) (data_ *CreateSeriesResponse, err_ error) {
+	id string,
+) (data_ *GetRedirectionRulesResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "CreateSeries",
-		Query:  CreateSeries_Operation,
-		Variables: &__CreateSeriesInput{
-			Input: input,
+		OpName: "GetRedirectionRules",
+		Query:  GetRedirectionRules_Operation,
+		Variables: &__GetRedirectionRulesInput{
+			Id: id,
 		},
 	}
 
-	data_ = &CreateSeriesResponse{}
+	data_ = &GetRedirectionRulesResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1049,36 +3802,49 @@ func CreateSeries(
 	return data_, err_
 }
 
-// The query executed by GetMe.
-const GetMe_Operation = `
-query GetMe {
-	me {
-		id
-		username
-		publications(first: 5) {
+// The query executed by GetStaticPages.
+const GetStaticPages_Operation = `
+query GetStaticPages ($id: ObjectId!, $first: Int!, $after: String) {
+	publication(id: $id) {
+		staticPages(first: $first, after: $after) {
 			edges {
 				node {
 					id
 					title
-					url
+					slug
+					hidden
+					content {
+						markdown
+					}
 				}
 			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
 		}
 	}
 }
 `
 
-// --- 1. Identity & Discovery (For 'init') ---
-func GetMe(
+func GetStaticPages(
 	ctx_ context.Context,
 	client_ graphql.Client,
-) (data_ *GetMeResponse, err_ error) {
+	id string,
+	first int,
+	after *string,
+) (data_ *GetStaticPagesResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "GetMe",
-		Query:  GetMe_Operation,
+		OpName: "GetStaticPages",
+		Query:  GetStaticPages_Operation,
+		Variables: &__GetStaticPagesInput{
+			Id:    id,
+			First: first,
+			After: after,
+		},
 	}
 
-	data_ = &GetMeResponse{}
+	data_ = &GetStaticPagesResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1090,34 +3856,21 @@ func GetMe(
 	return data_, err_
 }
 
-// The query executed by GetPublicationData.
-const GetPublicationData_Operation = `
-query GetPublicationData ($id: ObjectId!, $first: Int!, $after: String) {
+// The query executed by ListPublicationPosts.
+const ListPublicationPosts_Operation = `
+query ListPublicationPosts ($id: ObjectId!, $first: Int!, $after: String, $filter: PublicationPostConnectionFilter) {
 	publication(id: $id) {
-		title
-		posts(first: $first, after: $after) {
+		posts(first: $first, after: $after, filter: $filter) {
 			edges {
 				node {
 					id
-					title
 					slug
-					brief
 					publishedAt
-					content {
-						markdown
-					}
-					tags {
-						name
-						slug
-					}
+					updatedAt
 					series {
-						id
 						name
 						slug
 					}
-					coverImage {
-						url
-					}
 				}
 			}
 			pageInfo {
@@ -1128,39 +3881,82 @@ query GetPublicationData ($id: ObjectId!, $first: Int!, $after: String) {
 		seriesList(first: 50) {
 			edges {
 				node {
-					id
-					name
-					slug
-					description {
-						markdown
-					}
+					... ImportSeries
 				}
 			}
 		}
 	}
 }
+fragment ImportSeries on Series {
+	id
+	name
+	slug
+	description {
+		markdown
+	}
+}
 `
 
-// --- 2. Content State & Import Engine ---
-// This query handles both 'plan' (checking diffs) and 'import' (downloading everything)
-func GetPublicationData(
+// Selective and incremental import list posts without content first and
+// fetch only the ones they keep with GetImportPost
+func ListPublicationPosts(
 	ctx_ context.Context,
 	client_ graphql.Client,
 	id string,
 	first int,
 	after *string,
-) (data_ *GetPublicationDataResponse, err_ error) {
+	filter *PublicationPostConnectionFilter,
+) (data_ *ListPublicationPostsResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "GetPublicationData",
-		Query:  GetPublicationData_Operation,
-		Variables: &__GetPublicationDataInput{
-			Id:    id,
-			First: first,
-			After: after,
+		OpName: "ListPublicationPosts",
+		Query:  ListPublicationPosts_Operation,
+		Variables: &__ListPublicationPostsInput{
+			Id:     id,
+			First:  first,
+			After:  after,
+			Filter: filter,
 		},
 	}
 
-	data_ = &GetPublicationDataResponse{}
+	data_ = &ListPublicationPostsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by PublishDraft.
+const PublishDraft_Operation = `
+mutation PublishDraft ($input: PublishDraftInput!) {
+	publishDraft(input: $input) {
+		post {
+			id
+			slug
+		}
+	}
+}
+`
+
+// Publish a draft created on Hashnode (e.g. imported by hn import)
+func PublishDraft(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input PublishDraftInput,
+) (data_ *PublishDraftResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "PublishDraft",
+		Query:  PublishDraft_Operation,
+		Variables: &__PublishDraftInput{
+			Input: input,
+		},
+	}
+
+	data_ = &PublishDraftResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1180,6 +3976,8 @@ mutation PublishPost ($input: PublishPostInput!) {
 			id
 			slug
 			url
+			publishedAt
+			updatedAt
 		}
 	}
 }
@@ -1248,6 +4046,79 @@ func RemovePost(
 	return data_, err_
 }
 
+// The mutation executed by RemoveRedirectionRule.
+const RemoveRedirectionRule_Operation = `
+mutation RemoveRedirectionRule ($input: RemoveRedirectionRuleInput!) {
+	removeRedirectionRule(input: $input) {
+		redirectionRule {
+			id
+		}
+	}
+}
+`
+
+func RemoveRedirectionRule(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input RemoveRedirectionRuleInput,
+) (data_ *RemoveRedirectionRuleResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "RemoveRedirectionRule",
+		Query:  RemoveRedirectionRule_Operation,
+		Variables: &__RemoveRedirectionRuleInput{
+			Input: input,
+		},
+	}
+
+	data_ = &RemoveRedirectionRuleResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by TriggerWebhookTest.
+const TriggerWebhookTest_Operation = `
+mutation TriggerWebhookTest ($input: TriggerWebhookTestInput!) {
+	triggerWebhookTest(input: $input) {
+		webhook {
+			id
+			url
+		}
+	}
+}
+`
+
+func TriggerWebhookTest(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input TriggerWebhookTestInput,
+) (data_ *TriggerWebhookTestResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "TriggerWebhookTest",
+		Query:  TriggerWebhookTest_Operation,
+		Variables: &__TriggerWebhookTestInput{
+			Input: input,
+		},
+	}
+
+	data_ = &TriggerWebhookTestResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The mutation executed by UpdatePost.
 const UpdatePost_Operation = `
 mutation UpdatePost ($input: UpdatePostInput!) {
@@ -1286,3 +4157,75 @@ func UpdatePost(
 
 	return data_, err_
 }
+
+// The mutation executed by UpdateRedirectionRule.
+const UpdateRedirectionRule_Operation = `
+mutation UpdateRedirectionRule ($input: UpdateRedirectionRuleInput!) {
+	updateRedirectionRule(input: $input) {
+		redirectionRule {
+			id
+		}
+	}
+}
+`
+
+func UpdateRedirectionRule(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input UpdateRedirectionRuleInput,
+) (data_ *UpdateRedirectionRuleResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateRedirectionRule",
+		Query:  UpdateRedirectionRule_Operation,
+		Variables: &__UpdateRedirectionRuleInput{
+			Input: input,
+		},
+	}
+
+	data_ = &UpdateRedirectionRuleResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by UpdateWebhook.
+const UpdateWebhook_Operation = `
+mutation UpdateWebhook ($input: UpdateWebhookInput!) {
+	updateWebhook(input: $input) {
+		webhook {
+			id
+		}
+	}
+}
+`
+
+func UpdateWebhook(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input UpdateWebhookInput,
+) (data_ *UpdateWebhookResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateWebhook",
+		Query:  UpdateWebhook_Operation,
+		Variables: &__UpdateWebhookInput{
+			Input: input,
+		},
+	}
+
+	data_ = &UpdateWebhookResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
@@ -8,10 +8,9 @@ import (
 	"adil-adysh/hashnode-cli/internal/state"
 )
 
-// LoadContentForPath returns parsed frontmatter and the markdown body (without frontmatter)
-// for a given path using staged snapshot if available, otherwise disk. Frontmatter errors
-// are returned to prevent bad publishes.
-func LoadContentForPath(st *state.Stage, path string) (*state.Frontmatter, string, error) {
+// LoadRawContentForPath returns the full markdown (frontmatter included) for a
+// path, from the staged snapshot if available, otherwise from disk.
+func LoadRawContentForPath(st *state.Stage, path string) ([]byte, error) {
 	np := state.NormalizePath(path)
 
 	var contentBytes []byte
@@ -23,20 +22,36 @@ func LoadContentForPath(st *state.Stage, path string) (*state.Frontmatter, strin
 		}
 	}
 	if contentBytes == nil {
-		fsPath := filepath.FromSlash(np)
-		if !filepath.IsAbs(fsPath) {
-			fsPath = filepath.Join(state.ProjectRootOrCwd(), fsPath)
-		}
-		contentBytes, rerr = os.ReadFile(fsPath)
+		contentBytes, rerr = os.ReadFile(AbsPath(np))
 	}
 	if rerr != nil {
-		return nil, "", fmt.Errorf("failed to read content for %s: %w", path, rerr)
+		return nil, fmt.Errorf("failed to read content for %s: %w", path, rerr)
 	}
+	return contentBytes, nil
+}
 
-	fm, bodyBytes, berr := state.ExtractFrontmatter(contentBytes)
+// LoadContentForPath returns parsed frontmatter and the markdown body (without frontmatter)
+// for a given path using staged snapshot if available, otherwise disk. fr reads the
+// frontmatter. Frontmatter errors are returned to prevent bad publishes.
+func LoadContentForPath(fr state.FrontmatterReader, st *state.Stage, path string) (*state.Frontmatter, string, error) {
+	contentBytes, err := LoadRawContentForPath(st, path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	fm, bodyBytes, berr := fr.Extract(contentBytes)
 	if berr != nil {
 		return nil, "", fmt.Errorf("failed to parse frontmatter for %s: %w", path, berr)
 	}
 	body := string(bodyBytes)
 	return fm, body, nil
 }
+
+// AbsPath resolves a repository-relative path against the project root.
+func AbsPath(p string) string {
+	fsPath := filepath.FromSlash(p)
+	if !filepath.IsAbs(fsPath) {
+		fsPath = filepath.Join(state.ProjectRootOrCwd(), fsPath)
+	}
+	return fsPath
+}
@@ -172,13 +172,14 @@ func tagsToInputs(tags []string) []api.PublishPostTagInput {
 		if name == "" {
 			continue
 		}
-		slug := slugifyTag(name)
+		slug := TagSlug(name)
 		out = append(out, api.PublishPostTagInput{Name: &name, Slug: &slug})
 	}
 	return out
 }
 
-func slugifyTag(s string) string {
+// TagSlug is the slug a frontmatter tag is published under.
+func TagSlug(s string) string {
 	s = strings.ToLower(s)
 	clean := strings.Map(func(r rune) rune {
 		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
@@ -197,16 +198,8 @@ func slugifyTag(s string) string {
 }
 
 func resolveSeriesID(name string, sum *state.Sum) string {
-	if sum == nil || len(sum.Series) == 0 {
-		return ""
-	}
-	slug := state.SeriesSlug(name)
-	for _, se := range sum.Series {
-		if strings.EqualFold(se.Name, name) || strings.EqualFold(se.Slug, slug) {
-			return se.SeriesID
-		}
-	}
-	return ""
+	se, _ := sum.FindSeries(name)
+	return se.SeriesID
 }
 
 func strPtr(v string) *string { return &v }
@@ -0,0 +1,39 @@
+package applyutil
+
+import (
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// WriteIdentity records a created post's ID and final slug in the frontmatter
+// of the file at path. The same edit is applied to the staged content and the
+// checksum of that result is returned, so the ledger matches the working file
+// whenever the file was not edited again after staging. The result is also
+// snapshotted, as the ledger checksum must resolve to content.
+func WriteIdentity(st *state.Stage, path, postID, slug string) (string, error) {
+	staged, err := LoadRawContentForPath(st, path)
+	if err != nil {
+		return "", err
+	}
+	edit := func(ed *state.FrontmatterEditor) error {
+		if err := ed.Set("hashnode_id", postID); err != nil {
+			return err
+		}
+		if slug == "" {
+			return nil
+		}
+		return ed.Set("slug", slug)
+	}
+
+	ed := state.NewFrontmatterEditor(staged)
+	if err := edit(ed); err != nil {
+		return "", err
+	}
+	if _, err := state.EditFrontmatterFile(AbsPath(path), edit); err != nil {
+		return "", err
+	}
+	snap, err := state.NewSnapshotStore().Create(ed.Bytes())
+	if err != nil {
+		return "", err
+	}
+	return snap.Checksum, nil
+}
@@ -0,0 +1,52 @@
+package applyutil
+
+import (
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// imageRe matches a markdown image and captures the text up to the target,
+// the target itself and the rest (optional title and closing parenthesis).
+var imageRe = regexp.MustCompile(`(!\[[^\]]*\]\(\s*<?)([^)\s>]+)(>?(?:\s+"[^"]*")?\s*\))`)
+
+// RewriteImageLinks points relative image references in a markdown body at
+// baseURL, resolving them against the article path (repo-relative), so
+// images committed next to an article resolve once published. Absolute and
+// root-relative targets, targets outside the repo and fenced code blocks are
+// left alone. An empty baseURL returns body unchanged.
+func RewriteImageLinks(body, articlePath, baseURL string) string {
+	if baseURL == "" {
+		return body
+	}
+	base := strings.TrimRight(baseURL, "/") + "/"
+	dir := path.Dir(articlePath)
+
+	lines := strings.Split(body, "\n")
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		lines[i] = imageRe.ReplaceAllStringFunc(line, func(m string) string {
+			parts := imageRe.FindStringSubmatch(m)
+			target := parts[2]
+			if strings.HasPrefix(target, "/") || strings.HasPrefix(target, "#") || strings.Contains(target, ":") {
+				return m
+			}
+			resolved := path.Clean(path.Join(dir, target))
+			if resolved == ".." || strings.HasPrefix(resolved, "../") {
+				return m
+			}
+			u := &url.URL{Path: resolved}
+			return parts[1] + base + u.EscapedPath() + parts[3]
+		})
+	}
+	return strings.Join(lines, "\n")
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 
@@ -20,6 +21,31 @@ type Publication struct {
 type Config struct {
 	Publications []Publication `yaml:"publications"`
 	Token        string        `yaml:"token"`
+	// APIEndpoint replaces the Hashnode GraphQL API, e.g. with a proxy. The
+	// token is sent there, so only the user can choose it, never a repo.
+	APIEndpoint string `yaml:"api_endpoint,omitempty"`
+}
+
+// DefaultAPIEndpoint is the Hashnode GraphQL API.
+const DefaultAPIEndpoint = "https://gql.hashnode.com"
+
+// EndpointEnv overrides the GraphQL endpoint, e.g. with a mock in CI.
+const EndpointEnv = "HASHNODE_API_ENDPOINT"
+
+// Endpoint returns the GraphQL endpoint the token is sent to: $EndpointEnv,
+// then api_endpoint, then DefaultAPIEndpoint. c may be nil.
+func (c *Config) Endpoint() (string, error) {
+	e := os.Getenv(EndpointEnv)
+	if e == "" && c != nil {
+		e = c.APIEndpoint
+	}
+	if e == "" {
+		return DefaultAPIEndpoint, nil
+	}
+	if u, err := url.Parse(e); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("API endpoint is not an absolute http(s) URL: %q", e)
+	}
+	return e, nil
 }
 
 func configDir() string {
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+func TestEndpointIsChosenByTheUser(t *testing.T) {
+	t.Setenv(EndpointEnv, "")
+	var none *Config
+	if got, err := none.Endpoint(); err != nil || got != DefaultAPIEndpoint {
+		t.Errorf("nil config endpoint = %q, %v", got, err)
+	}
+	cfg := &Config{APIEndpoint: "https://proxy.example.com/graphql"}
+	if got, _ := cfg.Endpoint(); got != cfg.APIEndpoint {
+		t.Errorf("endpoint = %q, want the home config's", got)
+	}
+	t.Setenv(EndpointEnv, "http://localhost:8080")
+	if got, _ := cfg.Endpoint(); got != "http://localhost:8080" {
+		t.Errorf("endpoint = %q, want $%s", got, EndpointEnv)
+	}
+	t.Setenv(EndpointEnv, "gql.example.com")
+	if _, err := cfg.Endpoint(); err == nil {
+		t.Error("expected a relative endpoint to be rejected")
+	}
+}
+
+func TestLoadRepoReportsUnknownKeys(t *testing.T) {
+	t.Chdir(t.TempDir())
+	state.ResetProjectRootCache()
+	t.Cleanup(state.ResetProjectRootCache)
+
+	for content, ok := range map[string]bool{
+		"":                                 true,
+		"apply:\n  parallel: 4\n":          true,
+		"apply:\n  paralel: 4\n":           false,
+		"api:\n  endpoint: https://x.io\n": false,
+		"frontmatter:\n  defaults:\n    tgs: [go]\n": false,
+	} {
+		if err := os.WriteFile(RepoConfigFile, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		_, err := LoadRepo()
+		if ok && err != nil {
+			t.Errorf("%q: unexpected error %v", content, err)
+		}
+		if !ok && (err == nil || !strings.Contains(err.Error(), "not found")) {
+			t.Errorf("%q: expected an unknown key error, got %v", content, err)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+	"time"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// DefaultPathTemplate reproduces the original YYYY/MM/<title>.md layout.
+const DefaultPathTemplate = `{{.Year}}/{{.Month}}/{{default .Slug (slugify .Title)}}.md`
+
+// PathData is what a content.path_template can refer to, e.g.
+// `{{.Series.Slug}}/{{.Slug}}.md` or, for one directory per post (a page
+// bundle, handy for keeping images next to the post),
+// `{{.PublishedAt.Year}}/{{.Slug}}/index.md`.
+type PathData struct {
+	ID          string // Remote post ID; empty for `hn new`
+	Title       string
+	Slug        string
+	Tags        []string
+	Series      SeriesData // Zero when the post is not in a series
+	PublishedAt time.Time
+	Year        string // PublishedAt as "2006"
+	Month       string // PublishedAt as "01"
+	Day         string // PublishedAt as "02"
+}
+
+// SeriesData identifies the series of a post in PathData.
+type SeriesData struct {
+	Name string
+	Slug string
+}
+
+// NewPathData fills the date fields from publishedAt and a missing slug
+// from the title.
+func NewPathData(title, slug string, publishedAt time.Time) PathData {
+	if slug == "" {
+		slug = state.Slugify(title)
+	}
+	return PathData{
+		Title:       title,
+		Slug:        slug,
+		PublishedAt: publishedAt,
+		Year:        publishedAt.Format("2006"),
+		Month:       publishedAt.Format("01"),
+		Day:         publishedAt.Format("02"),
+	}
+}
+
+var pathFuncs = template.FuncMap{
+	"slugify": state.Slugify,
+	"lower":   strings.ToLower,
+	// default returns def when v is empty: {{default "misc" .Series.Slug}}
+	"default": func(def, v string) string {
+		if v == "" {
+			return def
+		}
+		return v
+	},
+}
+
+func (c ContentConfig) pathTemplate() (*template.Template, error) {
+	text := c.PathTemplate
+	if text == "" {
+		text = DefaultPathTemplate
+	}
+	return template.New("path_template").Funcs(pathFuncs).Option("missingkey=error").Parse(text)
+}
+
+// PostPath expands the path template for a post into a clean repo-relative
+// path. Empty segments (e.g. .Series.Slug of a post outside a series) are
+// dropped. Use state.UniquePath to avoid overwriting an existing file.
+func (c ContentConfig) PostPath(d PathData) (string, error) {
+	tmpl, err := c.pathTemplate()
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, d); err != nil {
+		return "", err
+	}
+	p := path.Clean("/" + strings.TrimSpace(buf.String()))[1:]
+	if ext := strings.ToLower(path.Ext(p)); ext != ".md" && ext != ".markdown" {
+		return "", fmt.Errorf("%q does not end in .md", p)
+	}
+	if strings.HasPrefix(path.Base(p), ".") {
+		return "", fmt.Errorf("%q has an empty file name", p)
+	}
+	return p, nil
+}
+
+// validatePathTemplate parses the template and expands it for a sample post
+// so unknown fields are reported when hashnode.yml is loaded.
+func (c ContentConfig) validatePathTemplate() error {
+	sample := NewPathData("Sample Post", "", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	sample.Series = SeriesData{Name: "Sample Series", Slug: "sample-series"}
+	_, err := c.PostPath(sample)
+	return err
+}
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPostPath(t *testing.T) {
+	published := time.Date(2024, 3, 7, 10, 0, 0, 0, time.UTC)
+	d := NewPathData("Hello, World!", "hello-world-1", published)
+	inSeries := d
+	inSeries.Series = SeriesData{Name: "Go Tips", Slug: "go-tips"}
+
+	cases := []struct {
+		tmpl string
+		data PathData
+		want string
+	}{
+		{tmpl: "", data: d, want: "2024/03/hello-world.md"},
+		{tmpl: "{{.Series.Slug}}/{{.Slug}}.md", data: inSeries, want: "go-tips/hello-world-1.md"},
+		{tmpl: "posts/{{.Series.Slug}}/{{.Slug}}.md", data: d, want: "posts/hello-world-1.md"}, // Empty segment dropped
+		{tmpl: "{{.PublishedAt.Year}}/{{.Slug}}/index.md", data: d, want: "2024/hello-world-1/index.md"},
+		{tmpl: `{{default "misc" .Series.Slug}}/{{.Day}}-{{slugify .Title}}.md`, data: d, want: "misc/07-hello-world.md"},
+		{tmpl: "../../{{.Slug}}.md", data: d, want: "hello-world-1.md"}, // Cannot leave the repo
+	}
+	for _, c := range cases {
+		got, err := ContentConfig{PathTemplate: c.tmpl}.PostPath(c.data)
+		if err != nil || got != c.want {
+			t.Errorf("PostPath(%q) = %q, %v; want %q", c.tmpl, got, err, c.want)
+		}
+	}
+
+	for _, bad := range []string{"{{.Slug}}", "{{.Nope}}.md", "{{.Slug"} {
+		if err := (ContentConfig{PathTemplate: bad}).validatePathTemplate(); err == nil {
+			t.Errorf("expected template %q to be rejected", bad)
+		}
+	}
+	if _, err := (ContentConfig{PathTemplate: "posts/{{.Series.Slug}}.md"}).PostPath(d); err == nil {
+		t.Error("expected an empty file name to be rejected")
+	}
+}
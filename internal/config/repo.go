@@ -0,0 +1,171 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"adil-adysh/hashnode-cli/internal/lint"
+	"adil-adysh/hashnode-cli/internal/state"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RepoConfigFile is the committed, per-repository settings file at the repo root.
+const RepoConfigFile = "hashnode.yml"
+
+// RepoConfig holds project conventions shared by everyone working on the repo.
+// Unlike Config (per-user, in the home directory) it never contains secrets.
+type RepoConfig struct {
+	Content     ContentConfig     `yaml:"content"`
+	Frontmatter FrontmatterConfig `yaml:"frontmatter"`
+	Lint        lint.Rules        `yaml:"lint"`
+	Apply       ApplyConfig       `yaml:"apply"`
+	Plan        PlanConfig        `yaml:"plan"`
+	Images      ImagesConfig      `yaml:"images"`
+}
+
+// FrontmatterConfig selects how frontmatter is read.
+type FrontmatterConfig struct {
+	// Dialect is "auto" (default), "yaml", "toml" or "json".
+	Dialect string `yaml:"dialect"`
+	// Defaults fill fields an article leaves unset when it is applied, e.g.
+	// tags or toc. Title, slug and hashnode_id cannot have defaults.
+	Defaults *state.Frontmatter `yaml:"defaults"`
+}
+
+// ApplyConfig controls side effects of `hn apply` on the working tree.
+type ApplyConfig struct {
+	// WriteIdentity writes hashnode_id and the final slug into an article's
+	// frontmatter after it is created, so the file keeps its identity when moved.
+	WriteIdentity bool `yaml:"write_identity"`
+	// RedirectSlugs creates a permanent redirect from the old URL when an
+	// article's slug changes (same as `hn apply --redirect-slugs`).
+	RedirectSlugs bool `yaml:"redirect_slugs"`
+	// Parallel is the default for `hn apply --parallel`; 0 or 1 applies
+	// one item at a time.
+	Parallel int `yaml:"parallel"`
+}
+
+// PlanConfig tunes how `hn plan` and `hn apply` interpret staged changes.
+type PlanConfig struct {
+	// RenameThreshold is the similarity [0..1) above which a new file is treated
+	// as an edited rename of a tracked file that disappeared. 0 uses the default (0.5).
+	RenameThreshold float64 `yaml:"rename_threshold"`
+}
+
+// ContentConfig selects which files in the repo are content. Files can also
+// be excluded with gitignore-style .hnignore files.
+type ContentConfig struct {
+	// Roots are the directories holding articles and pages; a root "posts"
+	// is the same as the include pattern "posts/**".
+	Roots []string `yaml:"roots"`
+	// Include limits articles and pages to markdown files matching one of
+	// these patterns, relative to the repo root (e.g. "posts/**"). With
+	// neither Roots nor Include every markdown file that is not ignored is
+	// content.
+	Include []string `yaml:"include"`
+	// Ignore holds gitignore-style rules applied before any .hnignore.
+	Ignore []string `yaml:"ignore"`
+	// PathTemplate places posts that `hn import` has not seen and those
+	// created by `hn new` (see PostPath). Empty uses DefaultPathTemplate.
+	PathTemplate string `yaml:"path_template"`
+}
+
+// includePatterns merges Roots into Include.
+func (c ContentConfig) includePatterns() []string {
+	patterns := append([]string{}, c.Include...)
+	for _, r := range c.Roots {
+		if r = strings.Trim(filepath.ToSlash(r), "/"); r != "" && r != "." {
+			patterns = append(patterns, r+"/**")
+		}
+	}
+	return patterns
+}
+
+// ImagesConfig controls how image references in article bodies are published.
+type ImagesConfig struct {
+	// BaseURL, when set, rewrites relative image links (e.g. ![](img/a.png))
+	// to BaseURL plus the image's repo-relative path on apply, so images
+	// committed next to articles resolve on Hashnode. Typically a raw
+	// GitHub or CDN URL of the repo.
+	BaseURL string `yaml:"base_url"`
+}
+
+// RepoConfigPath returns the path of hashnode.yml at the project root.
+func RepoConfigPath() string {
+	return filepath.Join(state.ProjectRootOrCwd(), RepoConfigFile)
+}
+
+// LoadRepo reads hashnode.yml. A missing file yields the defaults; unknown
+// keys are errors so typos do not silently fall back to defaults.
+func LoadRepo() (*RepoConfig, error) {
+	cfg := &RepoConfig{Frontmatter: FrontmatterConfig{Dialect: state.DialectAuto}}
+	data, err := os.ReadFile(RepoConfigPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return nil, err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(cfg); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("invalid %s: %w", RepoConfigFile, err)
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", RepoConfigFile, err)
+	}
+	if cfg.Frontmatter.Dialect == "" {
+		cfg.Frontmatter.Dialect = state.DialectAuto
+	}
+	return cfg, nil
+}
+
+func (c *RepoConfig) validate() error {
+	if t := c.Plan.RenameThreshold; t < 0 || t >= 1 {
+		return fmt.Errorf("plan.rename_threshold must be at least 0 and below 1, got %v", t)
+	}
+	if c.Apply.Parallel < 0 {
+		return fmt.Errorf("apply.parallel must not be negative, got %d", c.Apply.Parallel)
+	}
+	if err := c.Lint.Validate(); err != nil {
+		return fmt.Errorf("lint: %w", err)
+	}
+	if _, err := state.NewFrontmatterReader(c.Frontmatter.Dialect); err != nil {
+		return fmt.Errorf("frontmatter: %w", err)
+	}
+	if err := state.ValidateFrontmatterDefaults(c.Frontmatter.Defaults); err != nil {
+		return fmt.Errorf("frontmatter.defaults: %w", err)
+	}
+	if err := c.Content.validatePathTemplate(); err != nil {
+		return fmt.Errorf("content.path_template: %w", err)
+	}
+	if u, err := url.Parse(c.Images.BaseURL); c.Images.BaseURL != "" && (err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "") {
+		return fmt.Errorf("images.base_url is not an absolute http(s) URL: %q", c.Images.BaseURL)
+	}
+	return nil
+}
+
+// FrontmatterReader reads frontmatter in the repository's dialect. The
+// dialect is checked when hashnode.yml is loaded; a nil config detects it.
+func (c *RepoConfig) FrontmatterReader() state.FrontmatterReader {
+	if c == nil {
+		return state.FrontmatterReader{}
+	}
+	r, _ := state.NewFrontmatterReader(c.Frontmatter.Dialect)
+	return r
+}
+
+// Activate pushes repo settings into the packages that consume them.
+func (c *RepoConfig) Activate() error {
+	if err := state.SetContentInclude(c.Content.includePatterns()); err != nil {
+		return fmt.Errorf("%s: content: %w", RepoConfigFile, err)
+	}
+	state.SetRepoIgnore(c.Content.Ignore, RepoConfigFile+" content.ignore")
+	return nil
+}
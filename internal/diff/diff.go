@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
 	"adil-adysh/hashnode-cli/internal/log"
 	"adil-adysh/hashnode-cli/internal/state"
@@ -28,6 +31,14 @@ type PlanItem struct {
 	Reason   string
 	OldPath  string // Source path if this is a RENAME
 	RemoteID string // The Hashnode ID (if known)
+	OldSlug  string // Slug recorded in the ledger, set when the frontmatter slug changed
+	NewSlug  string // Slug requested by the staged frontmatter
+
+	Kind     state.ItemType  // Resource kind; empty means article
+	Redirect *state.Redirect // Desired rule for TypeRedirect items (nil on delete)
+	Webhook  *state.Webhook  // Desired webhook for TypeWebhook items (nil on delete)
+
+	DependsOn []string // Planned resources applied before this one ("kind key"); set by provider.Order
 }
 
 // RegistryEntry is a lightweight representation of registry metadata used by diff
@@ -37,7 +48,9 @@ type RegistryEntry struct {
 	MarkdownPath string
 	SeriesID     string
 	RemotePostID string
+	DraftID      string // Hashnode draft the file was imported from
 	Checksum     string
+	Slug         string
 	LastSyncedAt string
 }
 
@@ -100,16 +113,40 @@ func FullDiff(articles []RegistryEntry) []PlanItem {
 	return plan
 }
 
+// PlanOptions tunes plan generation.
+type PlanOptions struct {
+	// RenameThreshold is the similarity [0..1) a new file must exceed to be
+	// treated as an edited rename of a tracked file that disappeared. A
+	// matching slug scores 1 and always passes. Zero means
+	// DefaultRenameThreshold.
+	RenameThreshold float64
+	// Frontmatter reads the staged files; the zero value detects the dialect.
+	Frontmatter state.FrontmatterReader
+}
+
+// DefaultRenameThreshold is used when PlanOptions.RenameThreshold is unset.
+const DefaultRenameThreshold = 0.5
+
 // GeneratePlan compares the STAGE against the LEDGER (Registry).
 // Used by `hnsync plan` and `hnsync apply`.
 func GeneratePlan(articles []RegistryEntry, st *state.Stage) []PlanItem {
+	return GeneratePlanWithOptions(articles, st, PlanOptions{})
+}
+
+// GeneratePlanWithOptions is GeneratePlan with explicit options.
+func GeneratePlanWithOptions(articles []RegistryEntry, st *state.Stage, opts PlanOptions) []PlanItem {
 	var plan []PlanItem
+	threshold := opts.RenameThreshold
+	if threshold <= 0 {
+		threshold = DefaultRenameThreshold
+	}
 
 	// ---------------------------------------------------------
 	// 1. OPTIMIZATION: Build Lookups ONCE (O(N))
 	// ---------------------------------------------------------
 	reg := make(map[string]RegistryEntry)
 	checksumToPath := make(map[string]string) // Key: Checksum, Value: Path
+	idToPath := make(map[string]string)       // Key: RemotePostID, Value: Path
 
 	for _, a := range articles {
 		norm := state.NormalizePath(a.MarkdownPath)
@@ -118,13 +155,45 @@ func GeneratePlan(articles []RegistryEntry, st *state.Stage) []PlanItem {
 		if a.RemotePostID != "" && a.Checksum != "" {
 			checksumToPath[a.Checksum] = norm
 		}
+		if a.RemotePostID != "" {
+			idToPath[a.RemotePostID] = norm
+		}
+	}
+
+	// SAFEGUARD: Only rename if old file is GONE (Deleted or Staged for Delete)
+	isGone := func(oldPath string) bool {
+		if _, err := os.Stat(resolveAbsPath(oldPath)); os.IsNotExist(err) {
+			return true
+		}
+		oldItem, ok := st.Items[oldPath]
+		return ok && oldItem.Operation == state.OpDelete
+	}
+	// Each tracked path can be the source of at most one rename
+	consumed := make(map[string]bool)
+
+	// Visit staged paths in a stable order so rename matching is deterministic
+	paths := make([]string, 0, len(st.Items))
+	for rawPath := range st.Items {
+		paths = append(paths, rawPath)
 	}
+	sort.Strings(paths)
+
+	// New files without an exact match; resolved by similarity below
+	type pendingNew struct {
+		path string
+		item state.StagedItem
+	}
+	var pending []pendingNew
 
 	// ---------------------------------------------------------
 	// 2. PROCESS STAGE (O(M))
 	// ---------------------------------------------------------
-	for rawPath, stagedItem := range st.Items {
+	for _, rawPath := range paths {
+		stagedItem := st.Items[rawPath]
 		path := state.NormalizePath(rawPath)
+		if stagedItem.Type != "" && stagedItem.Type != state.TypeArticle {
+			continue // Planned by the resource's own differ (e.g. PlanRedirects)
+		}
 
 		// Handle explicit delete intent
 		if stagedItem.Operation == state.OpDelete {
@@ -169,20 +238,48 @@ func GeneratePlan(articles []RegistryEntry, st *state.Stage) []PlanItem {
 		// ---------------------------------------------------------
 		entry, exists := reg[path]
 
+		// IDENTITY: hashnode_id in frontmatter beats the path
+		if id := stagedHashnodeID(opts.Frontmatter, path, stagedItem); id != "" && (!exists || entry.RemotePostID != id) {
+			if oldPath, known := idToPath[id]; known && oldPath != path {
+				oldEntry := reg[oldPath]
+				if !isGone(oldPath) {
+					plan = append(plan, PlanItem{
+						Type:     ActionSkip,
+						Path:     path,
+						RemoteID: id,
+						Reason:   fmt.Sprintf("hashnode_id %s is also tracked at %s; move or delete one of them", id, oldPath),
+					})
+					continue
+				}
+				consumed[oldPath] = true
+				plan = append(plan, PlanItem{
+					Type:     ActionUpdate,
+					Path:     path,
+					OldPath:  oldPath,
+					RemoteID: id,
+					Title:    oldEntry.Title,
+					Reason:   fmt.Sprintf("Rename detected (hashnode_id matches %s)", oldPath),
+				})
+				continue
+			}
+			// Not in this ledger: the file was moved here from another repo
+			plan = append(plan, PlanItem{
+				Type:     ActionUpdate,
+				ID:       entry.LocalID,
+				Title:    entry.Title,
+				Path:     path,
+				RemoteID: id,
+				Reason:   fmt.Sprintf("Identity from frontmatter (hashnode_id %s)", id),
+			})
+			continue
+		}
+
 		// CASE A: NEW FILE (Not in Registry)
 		if !exists {
 			// RENAME HEURISTIC: Does this content exist elsewhere?
-			if oldPath, found := checksumToPath[currentHash]; found {
-				// SAFEGUARD: Only rename if old file is GONE (Deleted or Staged for Delete)
-				isGone := false
-				if _, err := os.Stat(resolveAbsPath(oldPath)); os.IsNotExist(err) {
-					isGone = true
-				}
-				if oldItem, ok := st.Items[state.NormalizePath(oldPath)]; ok && oldItem.Operation == state.OpDelete {
-					isGone = true
-				}
-
-				if isGone {
+			if oldPath, found := checksumToPath[currentHash]; found && !consumed[oldPath] {
+				if isGone(oldPath) {
+					consumed[oldPath] = true
 					oldEntry := reg[oldPath]
 					plan = append(plan, PlanItem{
 						Type:     ActionUpdate, // Treat Rename as an Update
@@ -197,12 +294,7 @@ func GeneratePlan(articles []RegistryEntry, st *state.Stage) []PlanItem {
 				// If old file exists, it's a COPY, so fall through to Create.
 			}
 
-			// Truly New
-			plan = append(plan, PlanItem{
-				Type:   ActionCreate,
-				Path:   path,
-				Reason: "New Article (Staged)",
-			})
+			pending = append(pending, pendingNew{path: path, item: stagedItem})
 			continue
 		}
 
@@ -223,9 +315,205 @@ func GeneratePlan(articles []RegistryEntry, st *state.Stage) []PlanItem {
 		})
 	}
 
+	// ---------------------------------------------------------
+	// 4. EDITED RENAMES: match remaining new files against tracked
+	//    files that disappeared, by frontmatter identity and body similarity
+	// ---------------------------------------------------------
+	var candidates []RegistryEntry
+	for _, a := range articles {
+		norm := state.NormalizePath(a.MarkdownPath)
+		if a.RemotePostID == "" || consumed[norm] {
+			continue
+		}
+		if item, staged := st.Items[norm]; staged && item.Operation != state.OpDelete {
+			continue
+		}
+		if isGone(norm) {
+			candidates = append(candidates, a)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].MarkdownPath < candidates[j].MarkdownPath })
+
+	for _, p := range pending {
+		if len(candidates) > 0 {
+			if content, err := readStaged(p.path, p.item); err == nil {
+				best, bestScore := -1, 0.0
+				for i, c := range candidates {
+					if consumed[state.NormalizePath(c.MarkdownPath)] {
+						continue
+					}
+					if score := renameScore(opts.Frontmatter, c, content); score > threshold && score > bestScore {
+						best, bestScore = i, score
+					}
+				}
+				if best >= 0 {
+					old := candidates[best]
+					oldPath := state.NormalizePath(old.MarkdownPath)
+					consumed[oldPath] = true
+					plan = append(plan, PlanItem{
+						Type:     ActionUpdate,
+						Path:     p.path,
+						OldPath:  oldPath,
+						RemoteID: old.RemotePostID,
+						Title:    old.Title,
+						Reason:   fmt.Sprintf("Rename detected (%d%% similar to missing %s)", int(bestScore*100+0.5), oldPath),
+					})
+					continue
+				}
+			}
+		}
+
+		// Truly New
+		plan = append(plan, PlanItem{
+			Type:   ActionCreate,
+			Path:   p.path,
+			Reason: "New Article (Staged)",
+		})
+	}
+
+	// DRAFTS: a post whose frontmatter keeps it unpublished (e.g. an imported
+	// draft) is not created until that changes. A published post cannot be
+	// unpublished through the API, so such an edit is skipped as well.
+	for i, it := range plan {
+		if it.Type != ActionCreate && it.Type != ActionUpdate {
+			continue
+		}
+		fm := stagedFrontmatter(opts.Frontmatter, it.Path, st.Items[it.Path])
+		var reason string
+		if cause := unpublishedCause(fm); cause != "" && it.Type == ActionCreate {
+			reason = fmt.Sprintf("Draft (%s); not published", cause)
+		} else if cause != "" {
+			reason = fmt.Sprintf("Already published but %s; Hashnode cannot unpublish a post, so nothing is applied (hn rm deletes it)", cause)
+		} else if reg[it.Path].DraftID != "" && fm != nil && fm.PublishedAt != nil {
+			// Hashnode publishes a scheduled draft itself; creating it here
+			// would publish it twice
+			reason = "Scheduled draft; Hashnode publishes it, run hn import to link the post (remove published_at to publish it now)"
+		}
+		if reason != "" {
+			plan[i] = PlanItem{Type: ActionSkip, ID: it.ID, Title: it.Title, Path: it.Path, RemoteID: it.RemoteID, Reason: reason}
+		}
+	}
+
+	// SLUG CHANGES: the staged frontmatter asks for a different slug than the
+	// one recorded in the ledger, which breaks the old URL
+	for i, it := range plan {
+		if it.Type != ActionUpdate {
+			continue
+		}
+		known := reg[it.Path]
+		if it.OldPath != "" {
+			known = reg[it.OldPath]
+		}
+		if known.Slug == "" {
+			continue
+		}
+		fm := stagedFrontmatter(opts.Frontmatter, it.Path, st.Items[it.Path])
+		if fm == nil {
+			continue
+		}
+		if slug := strings.TrimSpace(fm.Slug); slug != "" && slug != known.Slug {
+			plan[i].OldSlug, plan[i].NewSlug = known.Slug, slug
+			plan[i].Reason += fmt.Sprintf("; slug change %s → %s", known.Slug, slug)
+		}
+	}
+
+	// A renamed file takes over the remote post, so the old path must not
+	// delete it.
+	renamedTo := make(map[string]string)
+	for _, it := range plan {
+		if it.OldPath != "" {
+			renamedTo[it.OldPath] = it.Path
+		}
+	}
+	for i, it := range plan {
+		if it.Type == ActionDelete {
+			if newPath, ok := renamedTo[it.Path]; ok {
+				plan[i] = PlanItem{Type: ActionSkip, Path: it.Path, RemoteID: it.RemoteID, Reason: fmt.Sprintf("Renamed to %s", newPath)}
+			}
+		}
+	}
+
+	SortPlan(plan)
 	return plan
 }
 
+// ActionRank is the position of an action in the fixed plan order: deletes
+// first so they free slugs and URLs that creates may claim, then creates,
+// updates and finally skips.
+func ActionRank(a ActionType) int {
+	switch a {
+	case ActionDelete:
+		return 0
+	case ActionCreate:
+		return 1
+	case ActionUpdate:
+		return 2
+	}
+	return 3
+}
+
+// SortPlan puts plan into the fixed order (see ActionRank), by path within
+// each action, so the same inputs always print and apply identically.
+func SortPlan(plan []PlanItem) {
+	sort.SliceStable(plan, func(i, j int) bool {
+		if ri, rj := ActionRank(plan[i].Type), ActionRank(plan[j].Type); ri != rj {
+			return ri < rj
+		}
+		if plan[i].Path != plan[j].Path {
+			return plan[i].Path < plan[j].Path
+		}
+		return plan[i].Title < plan[j].Title
+	})
+}
+
+// readStaged returns the staged content of path (snapshot first, then disk).
+func readStaged(path string, item state.StagedItem) ([]byte, error) {
+	if item.Snapshot != "" {
+		if content, err := state.NewSnapshotStore().Get(item.Snapshot); err == nil {
+			return content, nil
+		}
+	}
+	return os.ReadFile(resolveAbsPath(path))
+}
+
+// stagedFrontmatter parses the frontmatter of the staged content of path. It
+// returns nil when the content is unreadable or has no valid frontmatter.
+func stagedFrontmatter(fr state.FrontmatterReader, path string, item state.StagedItem) *state.Frontmatter {
+	content, err := readStaged(path, item)
+	if err != nil {
+		return nil
+	}
+	fm, _, err := fr.Extract(content)
+	if err != nil {
+		return nil
+	}
+	return fm
+}
+
+// stagedHashnodeID returns the hashnode_id declared in the staged content of
+// path, or "" when absent or unreadable.
+func stagedHashnodeID(fr state.FrontmatterReader, path string, item state.StagedItem) string {
+	if fm := stagedFrontmatter(fr, path, item); fm != nil {
+		return strings.TrimSpace(fm.HashnodeID)
+	}
+	return ""
+}
+
+// unpublishedCause says what in fm keeps a post unpublished: `published:
+// false` or a published_at still in the future. It returns "" otherwise.
+func unpublishedCause(fm *state.Frontmatter) string {
+	if fm == nil {
+		return ""
+	}
+	if fm.Published != nil && !*fm.Published {
+		return "published: false"
+	}
+	if fm.PublishedAt != nil && fm.PublishedAt.After(time.Now()) {
+		return fmt.Sprintf("published_at %s is in the future", fm.PublishedAt.Format(time.RFC3339))
+	}
+	return ""
+}
+
 // determineAction contains the pure business logic for state transitions.
 func determineAction(currentHash, knownHash, remoteID string) (ActionType, string) {
 	if remoteID == "" {
@@ -0,0 +1,523 @@
+package diff_test
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"adil-adysh/hashnode-cli/internal/diff"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+const postID = "65a1b2c3d4e5f6a7b8c9d0e1"
+
+// setupProject creates a temp project root and chdirs into it.
+func setupProject(t *testing.T) string {
+	t.Helper()
+	tempDir := t.TempDir()
+	origDir, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(origDir)
+		state.ResetProjectRootCache()
+	})
+	if err := os.Chdir(tempDir); err != nil {
+		t.Fatalf("chdir failed: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(tempDir, ".hashnode"), 0755); err != nil {
+		t.Fatalf("mkdir .hashnode failed: %v", err)
+	}
+	state.ResetProjectRootCache()
+	return tempDir
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func planFor(t *testing.T, path string, plan []diff.PlanItem) diff.PlanItem {
+	t.Helper()
+	for _, it := range plan {
+		if it.Path == path {
+			return it
+		}
+	}
+	t.Fatalf("no plan item for %s in %+v", path, plan)
+	return diff.PlanItem{}
+}
+
+func TestGeneratePlanIdentityRename(t *testing.T) {
+	dir := setupProject(t)
+	// Moved and edited in one change: checksums differ, hashnode_id matches.
+	writeFile(t, filepath.Join(dir, "posts", "new.md"), "---\ntitle: Moved Post\nhashnode_id: "+postID+"\n---\nEdited body\n")
+	if err := state.StageAdd(filepath.Join(dir, "posts", "new.md")); err != nil {
+		t.Fatal(err)
+	}
+	if err := state.StageRemove("old.md"); err != nil {
+		t.Fatal(err)
+	}
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	articles := []diff.RegistryEntry{{MarkdownPath: "old.md", RemotePostID: postID, Checksum: "stale"}}
+	plan := diff.GeneratePlan(articles, st)
+
+	it := planFor(t, "posts/new.md", plan)
+	if it.Type != diff.ActionUpdate || it.OldPath != "old.md" || it.RemoteID != postID {
+		t.Fatalf("expected rename update from old.md, got %+v", it)
+	}
+	if old := planFor(t, "old.md", plan); old.Type != diff.ActionSkip {
+		t.Fatalf("old path must not delete the renamed post, got %+v", old)
+	}
+}
+
+func TestGeneratePlanIdentityFromOtherRepo(t *testing.T) {
+	dir := setupProject(t)
+	writeFile(t, filepath.Join(dir, "post.md"), "---\ntitle: Imported Post\nhashnode_id: "+postID+"\n---\nBody\n")
+	if err := state.StageAdd(filepath.Join(dir, "post.md")); err != nil {
+		t.Fatal(err)
+	}
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	it := planFor(t, "post.md", diff.GeneratePlan(nil, st))
+	if it.Type != diff.ActionUpdate || it.RemoteID != postID {
+		t.Fatalf("expected update of %s, got %+v", postID, it)
+	}
+}
+
+func TestGeneratePlanIdentityConflict(t *testing.T) {
+	dir := setupProject(t)
+	writeFile(t, filepath.Join(dir, "old.md"), "---\ntitle: Original Post\nhashnode_id: "+postID+"\n---\nBody\n")
+	writeFile(t, filepath.Join(dir, "copy.md"), "---\ntitle: Copied Post\nhashnode_id: "+postID+"\n---\nBody\n")
+	if err := state.StageAdd(filepath.Join(dir, "copy.md")); err != nil {
+		t.Fatal(err)
+	}
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	articles := []diff.RegistryEntry{{MarkdownPath: "old.md", RemotePostID: postID, Checksum: "x"}}
+	if it := planFor(t, "copy.md", diff.GeneratePlan(articles, st)); it.Type != diff.ActionSkip {
+		t.Fatalf("expected copy claiming a tracked id to be skipped, got %+v", it)
+	}
+}
+
+func TestGeneratePlanEditedRename(t *testing.T) {
+	dir := setupProject(t)
+	oldContent := "---\ntitle: Original Post\n---\nIntro paragraph.\n\nSecond paragraph.\n\nThird paragraph with a tpyo.\n\nConclusion.\n"
+	snap, err := state.NewSnapshotStore().Create([]byte(oldContent))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Renamed, retitled and the typo fixed: no identity, only the body matches.
+	writeFile(t, filepath.Join(dir, "posts", "renamed.md"), "---\ntitle: Better Title\n---\nIntro paragraph.\n\nSecond paragraph.\n\nThird paragraph with a typo.\n\nConclusion.\n")
+	writeFile(t, filepath.Join(dir, "unrelated.md"), "---\ntitle: Something Else\n---\nNothing in common.\n")
+	for _, p := range []string{"posts/renamed.md", "unrelated.md"} {
+		if err := state.StageAdd(filepath.Join(dir, p)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	articles := []diff.RegistryEntry{{MarkdownPath: "old.md", RemotePostID: postID, Checksum: snap.Checksum, Title: "Original Post"}}
+
+	plan := diff.GeneratePlan(articles, st)
+	if it := planFor(t, "posts/renamed.md", plan); it.Type != diff.ActionUpdate || it.OldPath != "old.md" || it.RemoteID != postID {
+		t.Fatalf("expected edited rename from old.md, got %+v", it)
+	}
+	if it := planFor(t, "unrelated.md", plan); it.Type != diff.ActionCreate {
+		t.Fatalf("expected unrelated file to be created, got %+v", it)
+	}
+
+	strict := diff.GeneratePlanWithOptions(articles, st, diff.PlanOptions{RenameThreshold: 0.9})
+	if it := planFor(t, "posts/renamed.md", strict); it.Type != diff.ActionCreate {
+		t.Fatalf("expected create above threshold, got %+v", it)
+	}
+}
+
+func TestGeneratePlanSharedTitleIsNotRename(t *testing.T) {
+	dir := setupProject(t)
+	snap, err := state.NewSnapshotStore().Create([]byte("---\ntitle: Weekly notes\n---\nRead two books.\n\nFixed the bike.\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Same title, unrelated body: once with the old snapshot, once without.
+	writeFile(t, filepath.Join(dir, "week-2.md"), "---\ntitle: Weekly notes\n---\nShipped the release.\n\nWent hiking.\n")
+	writeFile(t, filepath.Join(dir, "week-3.md"), "---\ntitle: Weekly notes\n---\nRead two books.\n\nFixed the bike.\n")
+	for _, p := range []string{"week-2.md", "week-3.md"} {
+		if err := state.StageAdd(filepath.Join(dir, p)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	articles := []diff.RegistryEntry{{MarkdownPath: "week-1.md", RemotePostID: postID, Checksum: snap.Checksum, Title: "Weekly notes"}}
+	plan := diff.GeneratePlan(articles, st)
+	if it := planFor(t, "week-2.md", plan); it.Type != diff.ActionCreate {
+		t.Fatalf("expected unrelated post with the same title to be created, got %+v", it)
+	}
+	if it := planFor(t, "week-3.md", plan); it.Type != diff.ActionUpdate || it.OldPath != "week-1.md" {
+		t.Fatalf("expected matching body to be a rename, got %+v", it)
+	}
+
+	gone := []diff.RegistryEntry{{MarkdownPath: "week-1.md", RemotePostID: postID, Checksum: "gone", Title: "Weekly notes"}}
+	for _, it := range diff.GeneratePlan(gone, st) {
+		if it.OldPath != "" {
+			t.Fatalf("expected no rename without the old content, got %+v", it)
+		}
+	}
+}
+
+func TestGeneratePlanRenameBySlug(t *testing.T) {
+	dir := setupProject(t)
+	writeFile(t, filepath.Join(dir, "new.md"), "---\ntitle: Rewritten Post\nslug: stable-slug\n---\nAll new text.\n")
+	if err := state.StageAdd(filepath.Join(dir, "new.md")); err != nil {
+		t.Fatal(err)
+	}
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	articles := []diff.RegistryEntry{{MarkdownPath: "old.md", RemotePostID: postID, Checksum: "gone", Slug: "stable-slug"}}
+	if it := planFor(t, "new.md", diff.GeneratePlan(articles, st)); it.Type != diff.ActionUpdate || it.OldPath != "old.md" {
+		t.Fatalf("expected slug to identify the renamed post, got %+v", it)
+	}
+}
+
+func TestGeneratePlanSlugChange(t *testing.T) {
+	dir := setupProject(t)
+	writeFile(t, filepath.Join(dir, "post.md"), "---\ntitle: Some Post\nslug: new-slug\n---\nBody\n")
+	if err := state.StageAdd(filepath.Join(dir, "post.md")); err != nil {
+		t.Fatal(err)
+	}
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	articles := []diff.RegistryEntry{{MarkdownPath: "post.md", RemotePostID: postID, Checksum: "old", Slug: "old-slug"}}
+	it := planFor(t, "post.md", diff.GeneratePlan(articles, st))
+	if it.Type != diff.ActionUpdate || it.OldSlug != "old-slug" || it.NewSlug != "new-slug" {
+		t.Fatalf("expected slug change old-slug → new-slug, got %+v", it)
+	}
+}
+
+func TestGeneratePlanSkipsUnpublishingPublishedPost(t *testing.T) {
+	dir := setupProject(t)
+	writeFile(t, filepath.Join(dir, "post.md"), "---\ntitle: Some Post\npublished: false\n---\nBody\n")
+	writeFile(t, filepath.Join(dir, "later.md"), "---\ntitle: Later\npublished_at: 2999-01-01T00:00:00Z\n---\nBody\n")
+	for _, p := range []string{"post.md", "later.md"} {
+		if err := state.StageAdd(filepath.Join(dir, p)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	articles := []diff.RegistryEntry{
+		{MarkdownPath: "post.md", RemotePostID: postID, Checksum: "old"},
+		{MarkdownPath: "later.md", RemotePostID: "other-post", Checksum: "old"},
+	}
+	plan := diff.GeneratePlan(articles, st)
+	for path, want := range map[string]string{"post.md": "published: false", "later.md": "in the future"} {
+		it := planFor(t, path, plan)
+		if it.Type != diff.ActionSkip || !strings.Contains(it.Reason, want) || !strings.Contains(it.Reason, "cannot unpublish") {
+			t.Errorf("%s: expected a skip explaining %q, got %+v", path, want, it)
+		}
+	}
+}
+
+func TestGeneratePlanFixedOrder(t *testing.T) {
+	dir := setupProject(t)
+	for _, name := range []string{"b-new.md", "a-edit.md", "c-edit.md", "a-new.md"} {
+		writeFile(t, filepath.Join(dir, name), "---\ntitle: "+name+"\n---\nBody\n")
+		if err := state.StageAdd(filepath.Join(dir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	st.Items["z-gone.md"] = state.StagedItem{Type: state.TypeArticle, Key: "z-gone.md", Operation: state.OpDelete}
+	st.Items["b-gone.md"] = state.StagedItem{Type: state.TypeArticle, Key: "b-gone.md", Operation: state.OpDelete}
+	articles := []diff.RegistryEntry{
+		{MarkdownPath: "a-edit.md", RemotePostID: "p1", Checksum: "old"},
+		{MarkdownPath: "c-edit.md", RemotePostID: "p2", Checksum: "old"},
+		{MarkdownPath: "z-gone.md", RemotePostID: "p3", Checksum: "old"},
+		{MarkdownPath: "b-gone.md", RemotePostID: "p4", Checksum: "old"},
+	}
+
+	want := []string{"DELETE b-gone.md", "DELETE z-gone.md", "CREATE a-new.md", "CREATE b-new.md", "UPDATE a-edit.md", "UPDATE c-edit.md"}
+	for run := 0; run < 5; run++ {
+		plan := diff.GeneratePlan(articles, st)
+		if len(plan) != len(want) {
+			t.Fatalf("unexpected plan: %+v", plan)
+		}
+		for i, it := range plan {
+			if got := string(it.Type) + " " + it.Path; got != want[i] {
+				t.Fatalf("run %d: item %d = %s, want %s", run, i, got, want[i])
+			}
+		}
+	}
+}
+
+func TestPlanRedirects(t *testing.T) {
+	desired := []state.Redirect{
+		{Source: "/keep", Destination: "/same", Type: state.RedirectPermanent},
+		{Source: "/move", Destination: "/elsewhere", Type: state.RedirectPermanent},
+		{Source: "/new", Destination: "/target", Type: state.RedirectTemporary},
+	}
+	remote := []diff.RemoteRedirect{
+		{ID: "r1", Source: "/keep", Destination: "https://blog.example.com/same", Type: "permanent"},
+		{ID: "r2", Source: "/move", Destination: "/old-target", Type: "permanent"},
+		{ID: "r3", Source: "/gone", Destination: "/x", Type: "temporary"},
+	}
+	want := map[string]diff.ActionType{
+		"/keep": diff.ActionSkip,
+		"/move": diff.ActionUpdate,
+		"/new":  diff.ActionCreate,
+		"/gone": diff.ActionDelete,
+	}
+	plan := diff.PlanRedirects(desired, remote)
+	if len(plan) != len(want) {
+		t.Fatalf("expected %d items, got %+v", len(want), plan)
+	}
+	for _, it := range plan {
+		if it.Kind != state.TypeRedirect || it.Type != want[it.Title] {
+			t.Errorf("%s: got %s (%s)", it.Title, it.Type, it.Reason)
+		}
+	}
+	if it := plan[0]; it.Title != "/gone" || it.RemoteID != "r3" {
+		t.Errorf("plan not sorted by source or missing rule id: %+v", it)
+	}
+
+	// Deleting redirects.yml must not wipe the remote rules
+	if plan := diff.PlanRedirects(nil, remote); len(plan) != 1 || plan[0].Type != diff.ActionSkip {
+		t.Errorf("expected a single skip, got %+v", plan)
+	}
+}
+
+func TestGeneratePlanIgnoresRedirectsFile(t *testing.T) {
+	dir := setupProject(t)
+	writeFile(t, filepath.Join(dir, state.RedirectsFile), "redirects:\n  - source: /a\n    destination: /b\n")
+	if err := state.StageAdd(filepath.Join(dir, state.RedirectsFile)); err != nil {
+		t.Fatal(err)
+	}
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan := diff.GeneratePlan(nil, st); len(plan) != 0 {
+		t.Errorf("redirects.yml planned as an article: %+v", plan)
+	}
+	rules, staged, err := diff.StagedRedirects(st)
+	if err != nil || !staged || len(rules) != 1 {
+		t.Errorf("StagedRedirects = %+v, %v, %v", rules, staged, err)
+	}
+}
+
+func TestPlanWebhooks(t *testing.T) {
+	deploy := state.Webhook{Name: "deploy", URL: "https://ci.example.com", Events: []string{"POST_UPDATED"}, SecretEnv: "S"}
+	search := state.Webhook{Name: "search", URL: "https://search.example.com", Events: []string{"POST_PUBLISHED"}, SecretEnv: "S"}
+	changed := search
+	changed.URL = "https://index.example.com"
+	applied := map[string]state.WebhookSum{
+		"deploy": {WebhookID: "w1", Checksum: state.WebhookChecksum(deploy)},
+		"search": {WebhookID: "w2", Checksum: state.WebhookChecksum(search)},
+		"old":    {WebhookID: "w3", Checksum: "x"},
+	}
+	fresh := state.Webhook{Name: "audit", URL: "https://audit.example.com", Events: []string{"POST_DELETED"}, SecretEnv: "S"}
+
+	want := map[string]diff.ActionType{
+		"audit":  diff.ActionCreate,
+		"deploy": diff.ActionSkip,
+		"old":    diff.ActionDelete,
+		"search": diff.ActionUpdate,
+	}
+	plan := diff.PlanWebhooks([]state.Webhook{deploy, changed, fresh}, applied)
+	if len(plan) != len(want) {
+		t.Fatalf("expected %d items, got %+v", len(want), plan)
+	}
+	for _, it := range plan {
+		if it.Kind != state.TypeWebhook || it.Type != want[it.Title] {
+			t.Errorf("%s: got %s (%s)", it.Title, it.Type, it.Reason)
+		}
+	}
+
+	// Deleting webhooks.yml deletes every webhook hn created
+	for _, it := range diff.PlanWebhooks(nil, applied) {
+		if it.Type != diff.ActionDelete {
+			t.Errorf("%s: expected delete, got %s", it.Title, it.Type)
+		}
+	}
+}
+
+func TestPlanPages(t *testing.T) {
+	dir := setupProject(t)
+	about := "---\ntitle: About\n---\nHello\n"
+	writeFile(t, filepath.Join(dir, "pages", "about.md"), about)
+	writeFile(t, filepath.Join(dir, "pages", "uses.md"), "---\ntitle: Uses\n---\nGear\n")
+	writeFile(t, filepath.Join(dir, "pages", "contact.md"), "---\ntitle: Contact\n---\nEdited\n")
+	if _, _, err := state.StageDir(filepath.Join(dir, "pages")); err != nil {
+		t.Fatal(err)
+	}
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if item := st.Items["pages/about.md"]; item.Type != state.TypePage {
+		t.Fatalf("page staged as %s", item.Type)
+	}
+
+	pages := map[string]state.PageSum{
+		"pages/about.md":   {PageID: "p1", Checksum: state.ChecksumFromContent([]byte(about))},
+		"pages/contact.md": {PageID: "p2", Checksum: "old"},
+	}
+	plan := diff.PlanPages(pages, st, state.FrontmatterReader{})
+	want := map[string]diff.ActionType{
+		"pages/about.md":   diff.ActionSkip,
+		"pages/contact.md": diff.ActionUpdate,
+		"pages/uses.md":    diff.ActionCreate,
+	}
+	if len(plan) != len(want) {
+		t.Fatalf("expected %d items, got %+v", len(want), plan)
+	}
+	for _, it := range plan {
+		if it.Kind != state.TypePage || it.Type != want[it.Path] {
+			t.Errorf("%s: got %s (%s)", it.Path, it.Type, it.Reason)
+		}
+	}
+	if got := planFor(t, "pages/uses.md", plan).Title; got != "Uses" {
+		t.Errorf("title not read from frontmatter: %q", got)
+	}
+	if len(diff.GeneratePlan(nil, st)) != 0 {
+		t.Error("pages planned as articles")
+	}
+}
+
+func TestUnified(t *testing.T) {
+	a := "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n"
+	b := "one\ntwo\nthree\nFOUR\nfive\nsix\nseven\neight\nnine\nten\neleven\n"
+	want := `--- a/post.md
++++ b/post.md
+@@ -1,10 +1,11 @@
+ one
+ two
+ three
+-four
++FOUR
+ five
+ six
+ seven
+ eight
+ nine
+ ten
++eleven
+`
+	if got := diff.Unified("a/post.md", "b/post.md", a, b); got != want {
+		t.Errorf("unexpected diff:\n%s", got)
+	}
+	if got := diff.Unified("a", "b", a, a); got != "" {
+		t.Errorf("expected no diff for equal input, got:\n%s", got)
+	}
+	if added, removed := diff.LineStat(a, b); added != 2 || removed != 1 {
+		t.Errorf("LineStat = +%d -%d, want +2 -1", added, removed)
+	}
+
+	// Distant changes get separate hunks; a new file starts at line 0
+	long := strings.Repeat("same\n", 20)
+	if got := diff.Unified("a", "b", "x\n"+long+"y\n", "X\n"+long+"Y\n"); strings.Count(got, "@@ -") != 2 {
+		t.Errorf("expected two hunks, got:\n%s", got)
+	}
+	if got := diff.Unified("a", "b", "", "new\n"); !strings.Contains(got, "@@ -0,0 +1,1 @@\n+new\n") {
+		t.Errorf("unexpected diff for a new file:\n%s", got)
+	}
+}
+
+func TestLineStatIsMinimal(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	lines := func() string {
+		var b strings.Builder
+		for n := rng.Intn(12); n > 0; n-- {
+			b.WriteString(string(rune('a'+rng.Intn(3))) + "\n")
+		}
+		return b.String()
+	}
+	for i := 0; i < 500; i++ {
+		a, b := lines(), lines()
+		// Length of the longest common subsequence, the smallest edit script
+		// removing and adding every other line.
+		al, bl := strings.Split(a, "\n"), strings.Split(b, "\n")
+		lcs := make([][]int, len(al))
+		for x := range lcs {
+			lcs[x] = make([]int, len(bl))
+		}
+		for x := len(al) - 2; x >= 0; x-- {
+			for y := len(bl) - 2; y >= 0; y-- {
+				if al[x] == bl[y] {
+					lcs[x][y] = lcs[x+1][y+1] + 1
+				} else {
+					lcs[x][y] = max(lcs[x+1][y], lcs[x][y+1])
+				}
+			}
+		}
+		added, removed := diff.LineStat(a, b)
+		if added != len(bl)-1-lcs[0][0] || removed != len(al)-1-lcs[0][0] {
+			t.Fatalf("LineStat(%q, %q) = +%d -%d, not minimal", a, b, added, removed)
+		}
+	}
+
+	// Large files with scattered edits are diffed without a quadratic table.
+	var a, b strings.Builder
+	for i := 0; i < 50000; i++ {
+		fmt.Fprintf(&a, "line %d\n", i)
+		if i%100 == 0 {
+			fmt.Fprintf(&b, "changed %d\n", i)
+		} else {
+			fmt.Fprintf(&b, "line %d\n", i)
+		}
+	}
+	if added, removed := diff.LineStat(a.String(), b.String()); added != 500 || removed != 500 {
+		t.Errorf("LineStat = +%d -%d, want +500 -500", added, removed)
+	}
+}
+
+func TestFrontmatterChanges(t *testing.T) {
+	off := false
+	old := &state.Frontmatter{Title: "Old", Tags: []string{"go"}, Slug: "same"}
+	new := &state.Frontmatter{Title: "New", Tags: []string{"go", "cli"}, Slug: "same", EnableToc: &off}
+	got := diff.FrontmatterChanges(old, new)
+	want := []diff.FieldChange{
+		{Field: "title", Old: "Old", New: "New"},
+		{Field: "tags", Old: "[go]", New: "[go, cli]"},
+		{Field: "toc", Old: "", New: "false"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("FrontmatterChanges = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("change %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
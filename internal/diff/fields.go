@@ -0,0 +1,72 @@
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// FieldChange is one frontmatter field that differs between two versions.
+// An empty Old or New means the field is unset on that side.
+type FieldChange struct {
+	Field string // YAML key
+	Old   string
+	New   string
+}
+
+// FrontmatterChanges lists the fields that differ between old and new, in
+// Frontmatter declaration order. Either side may be nil (no frontmatter).
+func FrontmatterChanges(old, new *state.Frontmatter) []FieldChange {
+	if old == nil {
+		old = &state.Frontmatter{}
+	}
+	if new == nil {
+		new = &state.Frontmatter{}
+	}
+	ov, nv := reflect.ValueOf(old).Elem(), reflect.ValueOf(new).Elem()
+	t := ov.Type()
+	var changes []FieldChange
+	for i := 0; i < t.NumField(); i++ {
+		key := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+		if key == "" || key == "-" {
+			continue
+		}
+		o, n := formatField(ov.Field(i)), formatField(nv.Field(i))
+		if o != n {
+			changes = append(changes, FieldChange{Field: key, Old: o, New: n})
+		}
+	}
+	return changes
+}
+
+// formatField renders a Frontmatter field value for display; unset is "".
+func formatField(v reflect.Value) string {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		if v = v.Elem(); v.Kind() == reflect.Bool {
+			return fmt.Sprint(v.Bool()) // An explicit false is set
+		}
+	}
+	switch x := v.Interface().(type) {
+	case string:
+		return x
+	case bool:
+		if !x {
+			return ""
+		}
+		return "true"
+	case []string:
+		if len(x) == 0 {
+			return ""
+		}
+		return "[" + strings.Join(x, ", ") + "]"
+	case time.Time:
+		return x.Format(time.RFC3339)
+	}
+	return fmt.Sprint(v.Interface())
+}
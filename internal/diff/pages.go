@@ -0,0 +1,37 @@
+package diff
+
+import (
+	"sort"
+	"strings"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// PlanPages plans the staged static pages (TypePage items) against their
+// ledger entries, keyed by path. fr reads the staged page titles.
+func PlanPages(pages map[string]state.PageSum, st *state.Stage, fr state.FrontmatterReader) []PlanItem {
+	var plan []PlanItem
+	for path, item := range st.Items {
+		if item.Type != state.TypePage {
+			continue
+		}
+		entry, tracked := pages[path]
+		it := PlanItem{Kind: state.TypePage, Path: path, Title: entry.Title, RemoteID: entry.PageID}
+		if item.Operation == state.OpDelete {
+			if tracked && entry.PageID != "" {
+				it.Type, it.Reason = ActionDelete, "Marked for deletion (staged)"
+			} else {
+				it.Type, it.Reason = ActionSkip, "Marked for deletion but not published remotely"
+			}
+			plan = append(plan, it)
+			continue
+		}
+		if fm := stagedFrontmatter(fr, path, item); fm != nil && strings.TrimSpace(fm.Title) != "" {
+			it.Title = strings.TrimSpace(fm.Title)
+		}
+		it.Type, it.Reason = determineAction(item.Checksum, entry.Checksum, entry.PageID)
+		plan = append(plan, it)
+	}
+	sort.Slice(plan, func(i, j int) bool { return plan[i].Path < plan[j].Path })
+	return plan
+}
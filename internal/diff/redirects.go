@@ -0,0 +1,143 @@
+package diff
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// RemoteRedirect is a redirection rule as it exists on the publication.
+type RemoteRedirect struct {
+	ID          string
+	Source      string
+	Destination string
+	Type        string // permanent or temporary
+}
+
+// StagedRedirects returns the rules of the staged redirects.yml. The bool is
+// false when redirects.yml is not staged; a nil slice with true means its
+// deletion is staged.
+func StagedRedirects(st *state.Stage) ([]state.Redirect, bool, error) {
+	content, staged, err := stagedFile(st, state.RedirectsFile)
+	if err != nil || content == nil {
+		return nil, staged, err
+	}
+	rules, err := state.ParseRedirects(content)
+	if err != nil {
+		return nil, true, err
+	}
+	if rules == nil {
+		rules = []state.Redirect{}
+	}
+	return rules, true, nil
+}
+
+// stagedFile returns the staged content of a repo-root resource file such as
+// redirects.yml. staged is false when the file is not staged; content is nil
+// when its deletion is staged.
+func stagedFile(st *state.Stage, name string) ([]byte, bool, error) {
+	item, ok := st.Items[name]
+	if !ok {
+		return nil, false, nil
+	}
+	if item.Operation == state.OpDelete {
+		return nil, true, nil
+	}
+	content, err := readStaged(name, item)
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to read staged %s: %w", name, err)
+	}
+	return content, true, nil
+}
+
+// PlanRedirects diffs the desired rules against the remote ones, matching by
+// source. A nil desired slice means redirects.yml was deleted: remote rules
+// are left alone rather than wiped.
+func PlanRedirects(desired []state.Redirect, remote []RemoteRedirect) []PlanItem {
+	if desired == nil {
+		return []PlanItem{{
+			Type:   ActionSkip,
+			Kind:   state.TypeRedirect,
+			Path:   state.RedirectsFile,
+			Reason: "redirects.yml deleted; remote rules left unchanged",
+		}}
+	}
+
+	bySource := make(map[string]RemoteRedirect, len(remote))
+	for _, r := range remote {
+		bySource[r.Source] = r
+	}
+	wanted := make(map[string]bool, len(desired))
+
+	var plan []PlanItem
+	for i := range desired {
+		want := desired[i]
+		wanted[want.Source] = true
+		item := PlanItem{
+			Kind:     state.TypeRedirect,
+			Path:     state.RedirectsFile,
+			Title:    want.Source,
+			Redirect: &want,
+		}
+		have, exists := bySource[want.Source]
+		switch {
+		case !exists:
+			item.Type = ActionCreate
+			item.Reason = fmt.Sprintf("create %s → %s (%s)", want.Source, want.Destination, want.Type)
+		default:
+			item.RemoteID = have.ID
+			var changes []string
+			if !sameDestination(have.Destination, want.Destination) {
+				changes = append(changes, fmt.Sprintf("destination %s → %s", have.Destination, want.Destination))
+			}
+			if !strings.EqualFold(have.Type, want.Type) {
+				changes = append(changes, fmt.Sprintf("type %s → %s", strings.ToLower(have.Type), want.Type))
+			}
+			if len(changes) == 0 {
+				item.Type = ActionSkip
+				item.Reason = "Up to date"
+			} else {
+				item.Type = ActionUpdate
+				item.Reason = "update " + strings.Join(changes, ", ")
+			}
+		}
+		plan = append(plan, item)
+	}
+
+	for _, have := range remote {
+		if wanted[have.Source] {
+			continue
+		}
+		plan = append(plan, PlanItem{
+			Type:     ActionDelete,
+			Kind:     state.TypeRedirect,
+			Path:     state.RedirectsFile,
+			Title:    have.Source,
+			RemoteID: have.ID,
+			Reason:   fmt.Sprintf("delete %s → %s (not in redirects.yml)", have.Source, have.Destination),
+		})
+	}
+
+	sort.SliceStable(plan, func(i, j int) bool { return plan[i].Title < plan[j].Title })
+	return plan
+}
+
+// sameDestination compares destinations, ignoring the host when only one side
+// has one (Hashnode may store relative paths as absolute URLs and vice versa).
+func sameDestination(a, b string) bool {
+	if a == b {
+		return true
+	}
+	ua, errA := url.Parse(a)
+	ub, errB := url.Parse(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	if ua.Host != "" && ub.Host != "" {
+		return strings.EqualFold(ua.Host, ub.Host) && ua.RequestURI() == ub.RequestURI()
+	}
+	return ua.RequestURI() == ub.RequestURI()
+}
@@ -0,0 +1,86 @@
+package diff
+
+import (
+	"strings"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// minBodySimilarity is the body similarity a rename needs on its own; a
+// shared title only counts once the bodies are this close, so unrelated
+// posts with a common title ("Weekly notes") are never matched.
+const minBodySimilarity = 0.3
+
+// renameScore rates how likely newContent is an edited copy of the tracked
+// article old, from 0 (unrelated) to 1 (same post).
+//
+// A matching frontmatter slug is conclusive since slugs are unique within a
+// publication. Otherwise the bodies are compared line by line against the
+// last applied content (kept as a snapshot under its ledger checksum); when
+// they are at least minBodySimilarity alike a matching title lifts the score
+// halfway towards 1. Without the old content there is nothing to compare and
+// the score is 0.
+func renameScore(fr state.FrontmatterReader, old RegistryEntry, newContent []byte) float64 {
+	fm, newBody, err := fr.Extract(newContent)
+	if err != nil {
+		return 0
+	}
+	var slug, title string
+	if fm != nil {
+		slug, title = strings.TrimSpace(fm.Slug), strings.TrimSpace(fm.Title)
+	}
+	if slug != "" && strings.EqualFold(slug, old.Slug) {
+		return 1
+	}
+	titleMatch := title != "" && strings.EqualFold(title, strings.TrimSpace(old.Title))
+
+	oldContent, err := state.NewSnapshotStore().GetContentByChecksum(old.Checksum)
+	if err != nil {
+		return 0
+	}
+	if oldFM, oldBody, err := fr.Extract(oldContent); err == nil {
+		if oldFM != nil && slug != "" && strings.EqualFold(slug, strings.TrimSpace(oldFM.Slug)) {
+			return 1
+		}
+		if oldFM != nil && !titleMatch {
+			titleMatch = title != "" && strings.EqualFold(title, strings.TrimSpace(oldFM.Title))
+		}
+		oldContent = oldBody
+	}
+
+	score := lineSimilarity(oldContent, newBody)
+	if score < minBodySimilarity {
+		return 0
+	}
+	if titleMatch {
+		score = (score + 1) / 2
+	}
+	return score
+}
+
+// lineSimilarity is the Dice coefficient over the multisets of non-blank,
+// whitespace-trimmed lines of a and b.
+func lineSimilarity(a, b []byte) float64 {
+	count := func(content []byte) map[string]int {
+		lines := make(map[string]int)
+		for _, l := range strings.Split(string(content), "\n") {
+			if l = strings.TrimSpace(l); l != "" {
+				lines[l]++
+			}
+		}
+		return lines
+	}
+	la, lb := count(a), count(b)
+	var total, common int
+	for l, n := range la {
+		total += n
+		common += min(n, lb[l])
+	}
+	for _, n := range lb {
+		total += n
+	}
+	if total == 0 {
+		return 1
+	}
+	return 2 * float64(common) / float64(total)
+}
@@ -0,0 +1,214 @@
+package diff
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ContextLines is the number of unchanged lines shown around each change.
+const ContextLines = 3
+
+// lineOp is one line of an edit script: ' ' kept, '-' removed, '+' added.
+type lineOp struct {
+	kind byte
+	text string
+}
+
+// splitLines splits text into lines, ignoring the final newline.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// lineOps computes a shortest edit script from a to b with Myers' algorithm
+// in linear space: the middle snake of the changed region splits it in two
+// and each half is solved the same way, so memory stays O(len(a)+len(b))
+// however large the files. Within each run of changes removed lines come
+// before added ones.
+func lineOps(a, b []string) []lineOp {
+	n := len(a) + len(b)
+	m := &myers{a: a, b: b, vf: make([]int, n+4), vb: make([]int, n+4), ops: make([]lineOp, 0, n)}
+	m.compare(0, len(a), 0, len(b))
+
+	ops := m.ops
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			i++
+			continue
+		}
+		j := i
+		for j < len(ops) && ops[j].kind != ' ' {
+			j++
+		}
+		slices.SortStableFunc(ops[i:j], func(p, q lineOp) int { return int(q.kind) - int(p.kind) }) // '-' sorts before '+'
+		i = j
+	}
+	return ops
+}
+
+// myers holds the state of one lineOps run. vf and vb are the furthest x
+// reached on each diagonal by the forward and backward searches.
+type myers struct {
+	a, b   []string
+	vf, vb []int
+	ops    []lineOp
+}
+
+// compare appends the edit script turning a[a0:a1] into b[b0:b1].
+func (m *myers) compare(a0, a1, b0, b1 int) {
+	for a0 < a1 && b0 < b1 && m.a[a0] == m.b[b0] {
+		m.ops = append(m.ops, lineOp{' ', m.a[a0]})
+		a0++
+		b0++
+	}
+	suf := 0
+	for a1-suf > a0 && b1-suf > b0 && m.a[a1-1-suf] == m.b[b1-1-suf] {
+		suf++
+	}
+	a1, b1 = a1-suf, b1-suf
+
+	switch {
+	case a0 == a1:
+		for _, l := range m.b[b0:b1] {
+			m.ops = append(m.ops, lineOp{'+', l})
+		}
+	case b0 == b1:
+		for _, l := range m.a[a0:a1] {
+			m.ops = append(m.ops, lineOp{'-', l})
+		}
+	default:
+		x, y, u, v := m.middleSnake(a0, a1, b0, b1)
+		m.compare(a0, x, b0, y)
+		for _, l := range m.a[x:u] {
+			m.ops = append(m.ops, lineOp{' ', l})
+		}
+		m.compare(u, a1, v, b1)
+	}
+	for _, l := range m.a[a1 : a1+suf] {
+		m.ops = append(m.ops, lineOp{' ', l})
+	}
+}
+
+// middleSnake finds the snake (x,y)-(u,v) in the middle of a shortest edit
+// script for a[a0:a1] and b[b0:b1] by searching from both ends at once. The
+// regions must differ in their first and last lines, so both halves around
+// the snake are smaller than the whole.
+func (m *myers) middleSnake(a0, a1, b0, b1 int) (x, y, u, v int) {
+	an, bn := a1-a0, b1-b0
+	delta := an - bn
+	odd := delta%2 != 0
+	limit := (an + bn + 1) / 2
+	off := limit + 1
+	vf, vb := m.vf[:2*limit+3], m.vb[:2*limit+3]
+	vf[off+1], vb[off+1] = 0, 0
+
+	for d := 0; d <= limit; d++ {
+		for k := -d; k <= d; k += 2 {
+			var px int
+			if k == -d || (k != d && vf[off+k-1] < vf[off+k+1]) {
+				px = vf[off+k+1]
+			} else {
+				px = vf[off+k-1] + 1
+			}
+			py := px - k
+			sx, sy := px, py
+			for px < an && py < bn && m.a[a0+px] == m.b[b0+py] {
+				px++
+				py++
+			}
+			vf[off+k] = px
+			if odd && delta-k >= -(d-1) && delta-k <= d-1 && px+vb[off+delta-k] >= an {
+				return a0 + sx, b0 + sy, a0 + px, b0 + py
+			}
+		}
+		for k := -d; k <= d; k += 2 {
+			var px int
+			if k == -d || (k != d && vb[off+k-1] < vb[off+k+1]) {
+				px = vb[off+k+1]
+			} else {
+				px = vb[off+k-1] + 1
+			}
+			py := px - k
+			sx, sy := px, py
+			for px < an && py < bn && m.a[a1-1-px] == m.b[b1-1-py] {
+				px++
+				py++
+			}
+			vb[off+k] = px
+			if !odd && delta-k >= -d && delta-k <= d && px+vf[off+delta-k] >= an {
+				return a1 - px, b1 - py, a1 - sx, b1 - sy
+			}
+		}
+	}
+	panic("diff: no middle snake")
+}
+
+// Unified returns a unified diff of a and b with ContextLines of context,
+// or "" when they are equal. fromName and toName label the two sides.
+func Unified(fromName, toName, a, b string) string {
+	ops := lineOps(splitLines(a), splitLines(b))
+
+	// Line numbers (0-based) in a and b before each op
+	aPos, bPos := make([]int, len(ops)+1), make([]int, len(ops)+1)
+	var changes []int
+	for k, op := range ops {
+		aPos[k+1], bPos[k+1] = aPos[k], bPos[k]
+		if op.kind != '+' {
+			aPos[k+1]++
+		}
+		if op.kind != '-' {
+			bPos[k+1]++
+		}
+		if op.kind != ' ' {
+			changes = append(changes, k)
+		}
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+
+	var out strings.Builder
+	fmt.Fprintf(&out, "--- %s\n+++ %s\n", fromName, toName)
+	for c := 0; c < len(changes); {
+		// Merge changes whose context would overlap into one hunk
+		last := c
+		for last+1 < len(changes) && changes[last+1]-changes[last] <= 2*ContextLines+1 {
+			last++
+		}
+		start := max(changes[c]-ContextLines, 0)
+		end := min(changes[last]+ContextLines+1, len(ops))
+
+		aStart, aLen := aPos[start], aPos[end]-aPos[start]
+		bStart, bLen := bPos[start], bPos[end]-bPos[start]
+		if aLen > 0 {
+			aStart++
+		}
+		if bLen > 0 {
+			bStart++
+		}
+		fmt.Fprintf(&out, "@@ -%d,%d +%d,%d @@\n", aStart, aLen, bStart, bLen)
+		for _, op := range ops[start:end] {
+			out.WriteByte(op.kind)
+			out.WriteString(op.text)
+			out.WriteByte('\n')
+		}
+		c = last + 1
+	}
+	return out.String()
+}
+
+// LineStat counts the lines added to and removed from a to produce b.
+func LineStat(a, b string) (added, removed int) {
+	for _, op := range lineOps(splitLines(a), splitLines(b)) {
+		switch op.kind {
+		case '+':
+			added++
+		case '-':
+			removed++
+		}
+	}
+	return added, removed
+}
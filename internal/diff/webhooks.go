@@ -0,0 +1,76 @@
+package diff
+
+import (
+	"fmt"
+	"sort"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// StagedWebhooks returns the webhooks of the staged webhooks.yml. The bool is
+// false when webhooks.yml is not staged; a nil slice with true means its
+// deletion is staged.
+func StagedWebhooks(st *state.Stage) ([]state.Webhook, bool, error) {
+	content, staged, err := stagedFile(st, state.WebhooksFile)
+	if err != nil || content == nil {
+		return nil, staged, err
+	}
+	hooks, err := state.ParseWebhooks(content)
+	if err != nil {
+		return nil, true, err
+	}
+	if hooks == nil {
+		hooks = []state.Webhook{}
+	}
+	return hooks, true, nil
+}
+
+// PlanWebhooks diffs the desired webhooks against those recorded in
+// hashnode.sum, matching by name. A nil desired slice means webhooks.yml was
+// deleted, which deletes every recorded webhook.
+func PlanWebhooks(desired []state.Webhook, applied map[string]state.WebhookSum) []PlanItem {
+	wanted := make(map[string]bool, len(desired))
+	var plan []PlanItem
+	for i := range desired {
+		want := desired[i]
+		wanted[want.Name] = true
+		item := PlanItem{
+			Kind:    state.TypeWebhook,
+			Path:    state.WebhooksFile,
+			Title:   want.Name,
+			Webhook: &want,
+		}
+		have, exists := applied[want.Name]
+		switch {
+		case !exists:
+			item.Type = ActionCreate
+			item.Reason = fmt.Sprintf("create %s → %s", want.Name, want.URL)
+		case have.Checksum != state.WebhookChecksum(want):
+			item.Type = ActionUpdate
+			item.RemoteID = have.WebhookID
+			item.Reason = fmt.Sprintf("update %s (url, events or secret_env changed)", want.Name)
+		default:
+			item.Type = ActionSkip
+			item.RemoteID = have.WebhookID
+			item.Reason = "Up to date"
+		}
+		plan = append(plan, item)
+	}
+
+	for name, have := range applied {
+		if wanted[name] {
+			continue
+		}
+		plan = append(plan, PlanItem{
+			Type:     ActionDelete,
+			Kind:     state.TypeWebhook,
+			Path:     state.WebhooksFile,
+			Title:    name,
+			RemoteID: have.WebhookID,
+			Reason:   fmt.Sprintf("delete %s (not in webhooks.yml)", name),
+		})
+	}
+
+	sort.SliceStable(plan, func(i, j int) bool { return plan[i].Title < plan[j].Title })
+	return plan
+}
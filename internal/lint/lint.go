@@ -0,0 +1,431 @@
+package lint
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// Severity classifies a diagnostic. Errors block stage/apply, warnings do not.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Default limits enforced by the linter. They mirror what the Hashnode editor
+// accepts; Rules can tighten or relax them per repo.
+const (
+	MinTitleLength    = 6
+	MaxTitleLength    = 250
+	MaxSubtitleLength = 150
+	MaxTags           = 5
+)
+
+// RuleNames lists the rule of every diagnostic the linter reports.
+var RuleNames = []string{
+	"frontmatter", "unknown-key", "title", "subtitle", "tags", "url",
+	"object-id", "series", "duplicate-slug", "broken-link",
+}
+
+// Rules tunes the checks for a repo (the lint section of hashnode.yml).
+// Zero limits use the package defaults.
+type Rules struct {
+	MinTitleLength    int      `yaml:"min_title_length"`
+	MaxTitleLength    int      `yaml:"max_title_length"`
+	MaxSubtitleLength int      `yaml:"max_subtitle_length"`
+	MaxTags           int      `yaml:"max_tags"`
+	Disable           []string `yaml:"disable"` // Rules not reported at all
+	Warn              []string `yaml:"warn"`    // Rules reported as warnings instead of errors
+}
+
+// Validate rejects negative limits and unknown rule names.
+func (r Rules) Validate() error {
+	for name, v := range map[string]int{
+		"min_title_length": r.MinTitleLength, "max_title_length": r.MaxTitleLength,
+		"max_subtitle_length": r.MaxSubtitleLength, "max_tags": r.MaxTags,
+	} {
+		if v < 0 {
+			return fmt.Errorf("%s must not be negative", name)
+		}
+	}
+	for _, name := range append(append([]string{}, r.Disable...), r.Warn...) {
+		if !slices.Contains(RuleNames, name) {
+			return fmt.Errorf("unknown rule %q (expected one of %s)", name, strings.Join(RuleNames, ", "))
+		}
+	}
+	return nil
+}
+
+func (r Rules) limit(v, def int) int {
+	if v > 0 {
+		return v
+	}
+	return def
+}
+
+// Diagnostic is a single finding tied to a file position.
+type Diagnostic struct {
+	Path     string
+	Line     int
+	Rule     string
+	Severity Severity
+	Message  string
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s:%d: %s: %s [%s]", d.Path, d.Line, d.Severity, d.Message, d.Rule)
+}
+
+// Source is a markdown document to lint. Content is linted as given so callers
+// can pass staged snapshots instead of the working file.
+type Source struct {
+	Path    string
+	Content []byte
+}
+
+// Options carries repository context used by cross-file rules.
+type Options struct {
+	// Sum is used to resolve `series` values and, with Stage, to find the
+	// slugs of articles that are not being linted. Nil skips the series check.
+	Sum *state.Sum
+	// Stage supplies the slugs of staged articles that are not being linted.
+	Stage *state.Stage
+	// Rules adjusts limits and severities; the zero value keeps the defaults.
+	Rules Rules
+	// Frontmatter reads the documents; the zero value detects the dialect.
+	Frontmatter state.FrontmatterReader
+}
+
+var (
+	objectIDRe = regexp.MustCompile(`^[0-9a-f]{24}$`)
+	tagRe      = regexp.MustCompile(`[a-z0-9]`)
+	linkRe     = regexp.MustCompile(`!?\[[^\]]*\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"]*")?\s*\)`)
+)
+
+// Lint checks every source and returns diagnostics sorted by path and line.
+func Lint(sources []Source, opts Options) []Diagnostic {
+	var diags []Diagnostic
+	slugs := make(map[string][]Diagnostic)
+
+	for _, src := range sources {
+		d, slug := lintFrontmatter(src, opts)
+		diags = append(diags, d...)
+		diags = append(diags, lintBody(src, opts.Frontmatter)...)
+		if slug != nil {
+			slugs[slug.Message] = append(slugs[slug.Message], *slug)
+		}
+	}
+
+	// Slugs must be unique across the linted set and the articles tracked or
+	// staged elsewhere in the repo.
+	linted := make(map[string]bool, len(sources))
+	for _, src := range sources {
+		linted[state.NormalizePath(src.Path)] = true
+	}
+	elsewhere := otherSlugs(opts, linted)
+	for slug, locs := range slugs {
+		for i, loc := range locs {
+			where := ""
+			if len(locs) > 1 {
+				other := locs[(i+1)%len(locs)]
+				where = fmt.Sprintf("%s:%d", other.Path, other.Line)
+			} else if path, ok := elsewhere[slug]; ok {
+				where = path
+			} else {
+				continue
+			}
+			diags = append(diags, Diagnostic{
+				Path:     loc.Path,
+				Line:     loc.Line,
+				Rule:     "duplicate-slug",
+				Severity: SeverityError,
+				Message:  fmt.Sprintf("slug %q is also used by %s", slug, where),
+			})
+		}
+	}
+
+	diags = opts.Rules.apply(diags)
+	sort.SliceStable(diags, func(i, j int) bool {
+		if diags[i].Path != diags[j].Path {
+			return diags[i].Path < diags[j].Path
+		}
+		return diags[i].Line < diags[j].Line
+	})
+	return diags
+}
+
+// otherSlugs maps the slug of every article outside linted to its path. A
+// staged article's frontmatter slug overrides its ledger slug and a staged
+// delete drops it. Tracked files missing from disk are left out: they are
+// being renamed or deleted and will not keep their slug.
+func otherSlugs(opts Options, linted map[string]bool) map[string]string {
+	byPath := make(map[string]string)
+	if opts.Sum != nil {
+		for path, a := range opts.Sum.Articles {
+			if _, err := os.Stat(absPath(path)); err == nil && a.Slug != "" {
+				byPath[path] = a.Slug
+			}
+		}
+	}
+	if opts.Stage != nil {
+		for path, it := range opts.Stage.Items {
+			if !isArticle(it) {
+				continue
+			}
+			if it.Operation == state.OpDelete {
+				delete(byPath, path)
+				continue
+			}
+			content, err := stagedContent(path, it)
+			if err != nil {
+				continue
+			}
+			if fm, _, err := opts.Frontmatter.Extract(content); err == nil && fm != nil && strings.TrimSpace(fm.Slug) != "" {
+				byPath[path] = strings.TrimSpace(fm.Slug)
+			}
+		}
+	}
+	bySlug := make(map[string]string)
+	for path, slug := range byPath {
+		if linted[path] {
+			continue
+		}
+		if prev, ok := bySlug[slug]; !ok || path < prev {
+			bySlug[slug] = path
+		}
+	}
+	return bySlug
+}
+
+// apply drops disabled rules and downgrades those configured as warnings.
+func (r Rules) apply(diags []Diagnostic) []Diagnostic {
+	if len(r.Disable) == 0 && len(r.Warn) == 0 {
+		return diags
+	}
+	out := diags[:0]
+	for _, d := range diags {
+		if slices.Contains(r.Disable, d.Rule) {
+			continue
+		}
+		if slices.Contains(r.Warn, d.Rule) {
+			d.Severity = SeverityWarning
+		}
+		out = append(out, d)
+	}
+	return out
+}
+
+// HasErrors reports whether any diagnostic has error severity.
+func HasErrors(diags []Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// lintFrontmatter checks the frontmatter of a single document. The second return
+// value carries the frontmatter slug (in Message) and its position for the
+// duplicate check.
+func lintFrontmatter(src Source, opts Options) ([]Diagnostic, *Diagnostic) {
+	var diags []Diagnostic
+	add := func(line int, rule string, sev Severity, format string, a ...interface{}) {
+		diags = append(diags, Diagnostic{Path: src.Path, Line: line, Rule: rule, Severity: sev, Message: fmt.Sprintf(format, a...)})
+	}
+
+	res, err := opts.Frontmatter.ExtractStrict(src.Content)
+	if err != nil {
+		_, _, fmLine, _, _ := state.SplitFrontmatter(src.Content)
+		add(max(fmLine, 1), "frontmatter", SeverityError, "%v", err)
+		return diags, nil
+	}
+	if res.Frontmatter == nil {
+		add(1, "title", SeverityError, "missing frontmatter with a 'title' field")
+		return diags, nil
+	}
+	fm := res.Frontmatter
+	fmLine := res.Line
+
+	known := state.FrontmatterKeys()
+	for _, issue := range res.Issues {
+		switch {
+		case !issue.Unknown:
+			add(issue.Line, "frontmatter", SeverityError, "%s", issue.Message)
+		case closestKey(issue.Key, known) != "":
+			add(issue.Line, "unknown-key", SeverityWarning, "unknown frontmatter key %q (did you mean %q?)", issue.Key, closestKey(issue.Key, known))
+		default:
+			add(issue.Line, "unknown-key", SeverityWarning, "unknown frontmatter key %q", issue.Key)
+		}
+	}
+	at := func(key string) int {
+		if l, ok := res.KeyLines[key]; ok {
+			return l
+		}
+		return fmLine
+	}
+
+	r := opts.Rules
+	minTitle, maxTitle := r.limit(r.MinTitleLength, MinTitleLength), r.limit(r.MaxTitleLength, MaxTitleLength)
+	maxSubtitle, maxTags := r.limit(r.MaxSubtitleLength, MaxSubtitleLength), r.limit(r.MaxTags, MaxTags)
+
+	title := strings.TrimSpace(fm.Title)
+	switch {
+	case title == "":
+		add(fmLine, "title", SeverityError, "missing 'title'")
+	case len(title) < minTitle:
+		add(at("title"), "title", SeverityError, "title must be at least %d characters", minTitle)
+	case len(title) > maxTitle:
+		add(at("title"), "title", SeverityError, "title exceeds %d characters", maxTitle)
+	}
+	if len(fm.Subtitle) > maxSubtitle {
+		add(at("subtitle"), "subtitle", SeverityError, "subtitle exceeds %d characters", maxSubtitle)
+	}
+
+	if len(fm.Tags) > maxTags {
+		add(at("tags"), "tags", SeverityError, "%d tags given; at most %d are allowed", len(fm.Tags), maxTags)
+	}
+	seenTags := make(map[string]bool)
+	for _, t := range fm.Tags {
+		name := strings.ToLower(strings.TrimSpace(t))
+		if !tagRe.MatchString(name) {
+			add(at("tags"), "tags", SeverityError, "tag %q does not produce a valid slug", t)
+			continue
+		}
+		if seenTags[name] {
+			add(at("tags"), "tags", SeverityWarning, "tag %q is listed more than once", t)
+		}
+		seenTags[name] = true
+	}
+
+	for key, v := range map[string]string{
+		"canonical":        fm.Canonical,
+		"cover_image_url":  fm.CoverImageURL,
+		"banner_image_url": fm.BannerImageURL,
+		"meta_image":       fm.MetaImage,
+	} {
+		if v != "" && !isAbsURL(v) {
+			add(at(key), "url", SeverityError, "%s is not an absolute http(s) URL: %q", key, v)
+		}
+	}
+
+	if fm.PublishAs != "" && !objectIDRe.MatchString(fm.PublishAs) {
+		add(at("publish_as"), "object-id", SeverityError, "publish_as must be a user ID, got %q", fm.PublishAs)
+	}
+	if fm.HashnodeID != "" && !objectIDRe.MatchString(fm.HashnodeID) {
+		add(at("hashnode_id"), "object-id", SeverityError, "hashnode_id must be a post ID, got %q", fm.HashnodeID)
+	}
+	for _, id := range fm.CoAuthors {
+		if !objectIDRe.MatchString(id) {
+			add(at("co_authors"), "object-id", SeverityError, "co_authors entries must be user IDs, got %q", id)
+		}
+	}
+
+	if fm.Series != "" && opts.Sum != nil {
+		if _, ok := opts.Sum.FindSeries(fm.Series); !ok {
+			add(at("series"), "series", SeverityError, "series %q not found in %s (run 'hn series create' or 'hn import')", fm.Series, state.SumFile)
+		}
+	}
+
+	if slug := strings.TrimSpace(fm.Slug); slug != "" {
+		return diags, &Diagnostic{Path: src.Path, Line: at("slug"), Message: slug}
+	}
+	return diags, nil
+}
+
+// lintBody reports relative markdown links whose target does not exist.
+// Fenced code blocks are skipped.
+func lintBody(src Source, fr state.FrontmatterReader) []Diagnostic {
+	_, body, _, _, err := fr.Split(src.Content)
+	if err != nil {
+		return nil
+	}
+	firstLine := 1 + bytes.Count(src.Content[:len(src.Content)-len(body)], []byte("\n"))
+	path := src.Path
+
+	var diags []Diagnostic
+	dir := filepath.Dir(filepath.FromSlash(path))
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(state.ProjectRootOrCwd(), dir)
+	}
+
+	inFence := false
+	for i, line := range strings.Split(string(body), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		for _, m := range linkRe.FindAllStringSubmatch(line, -1) {
+			target := m[1]
+			if target == "" || strings.HasPrefix(target, "#") || strings.HasPrefix(target, "/") || strings.Contains(target, ":") {
+				continue
+			}
+			if idx := strings.IndexAny(target, "#?"); idx >= 0 {
+				target = target[:idx]
+			}
+			if unescaped, err := url.PathUnescape(target); err == nil {
+				target = unescaped
+			}
+			if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(target))); err != nil {
+				diags = append(diags, Diagnostic{
+					Path:     path,
+					Line:     firstLine + i,
+					Rule:     "broken-link",
+					Severity: SeverityError,
+					Message:  fmt.Sprintf("relative link target %q does not exist", m[1]),
+				})
+			}
+		}
+	}
+	return diags
+}
+
+func isAbsURL(v string) bool {
+	u, err := url.Parse(v)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// closestKey suggests a known key within a small edit distance of key.
+func closestKey(key string, known map[string]bool) string {
+	best, bestDist := "", 3
+	for k := range known {
+		if d := editDistance(key, k); d < bestDist || (d == bestDist && best != "" && k < best) {
+			best, bestDist = k, d
+		}
+	}
+	return best
+}
+
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
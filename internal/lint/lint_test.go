@@ -0,0 +1,170 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+func findRule(diags []Diagnostic, rule string) *Diagnostic {
+	for i := range diags {
+		if diags[i].Rule == rule {
+			return &diags[i]
+		}
+	}
+	return nil
+}
+
+func TestLintValidArticle(t *testing.T) {
+	src := Source{Path: "post.md", Content: []byte("---\ntitle: A Valid Title\ntags: [go, cli]\ncanonical: https://example.com/a\n---\n\nBody\n")}
+	diags := Lint([]Source{src}, Options{})
+	if len(diags) != 0 {
+		t.Fatalf("expected no diagnostics, got %v", diags)
+	}
+}
+
+func TestLintUnknownKeyReportsLine(t *testing.T) {
+	src := Source{Path: "post.md", Content: []byte("\n---\ntitle: A Valid Title\ncanonial: https://example.com\n---\nBody\n")}
+	diags := Lint([]Source{src}, Options{})
+	d := findRule(diags, "unknown-key")
+	if d == nil {
+		t.Fatalf("expected unknown-key diagnostic, got %v", diags)
+	}
+	if d.Line != 4 {
+		t.Errorf("expected line 4, got %d", d.Line)
+	}
+	if !strings.Contains(d.Message, `"canonical"`) {
+		t.Errorf("expected suggestion for canonical, got %q", d.Message)
+	}
+	if HasErrors(diags) {
+		t.Errorf("unknown keys should only warn: %v", diags)
+	}
+}
+
+func TestLintFieldRules(t *testing.T) {
+	content := strings.Join([]string{
+		"---",
+		"title: Short",
+		"tags: [a, b, c, d, e, f]",
+		"cover_image_url: not-a-url",
+		"publish_as: someone",
+		"series: Missing Series",
+		"---",
+		"Body",
+	}, "\n")
+	sum := &state.Sum{Series: map[string]state.SeriesEntry{"go": {Name: "Go", Slug: "go"}}}
+	diags := Lint([]Source{{Path: "post.md", Content: []byte(content)}}, Options{Sum: sum})
+
+	want := map[string]int{"title": 2, "tags": 3, "url": 4, "object-id": 5, "series": 6}
+	for rule, line := range want {
+		d := findRule(diags, rule)
+		if d == nil {
+			t.Errorf("expected %s diagnostic, got %v", rule, diags)
+			continue
+		}
+		if d.Line != line {
+			t.Errorf("%s: expected line %d, got %d", rule, line, d.Line)
+		}
+	}
+}
+
+func TestLintDuplicateSlug(t *testing.T) {
+	a := Source{Path: "a.md", Content: []byte("---\ntitle: First Article\nslug: same\n---\n")}
+	b := Source{Path: "b.md", Content: []byte("---\ntitle: Second Article\nslug: same\n---\n")}
+	diags := Lint([]Source{a, b}, Options{})
+	var n int
+	for _, d := range diags {
+		if d.Rule == "duplicate-slug" {
+			n++
+		}
+	}
+	if n != 2 {
+		t.Fatalf("expected duplicate-slug on both files, got %v", diags)
+	}
+}
+
+func TestLintDuplicateSlugAcrossRepo(t *testing.T) {
+	t.Chdir(t.TempDir())
+	state.ResetProjectRootCache()
+	t.Cleanup(state.ResetProjectRootCache)
+	for name, content := range map[string]string{
+		"tracked.md": "---\ntitle: Tracked Article\n---\nBody\n",
+		"staged.md":  "---\ntitle: Staged Article\nslug: staged-slug\n---\nBody\n",
+	} {
+		if err := os.WriteFile(name, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sum := &state.Sum{Articles: map[string]state.ArticleSum{
+		"tracked.md": {PostID: "p1", Slug: "tracked-slug"},
+		"gone.md":    {PostID: "p2", Slug: "gone-slug"}, // Renamed away on disk
+	}}
+	// Items staged before types were recorded have no Type.
+	st := &state.Stage{Items: map[string]state.StagedItem{"staged.md": {Operation: state.OpModify}}}
+	opts := Options{Sum: sum, Stage: st}
+
+	for slug, other := range map[string]string{"tracked-slug": "tracked.md", "staged-slug": "staged.md", "gone-slug": ""} {
+		src := Source{Path: "new.md", Content: []byte("---\ntitle: A New Article\nslug: " + slug + "\n---\nBody\n")}
+		d := findRule(Lint([]Source{src}, opts), "duplicate-slug")
+		switch {
+		case other == "" && d != nil:
+			t.Errorf("%s: unexpected %v", slug, d)
+		case other != "" && (d == nil || !strings.Contains(d.Message, other)):
+			t.Errorf("%s: expected a duplicate of %s, got %v", slug, other, d)
+		}
+	}
+
+	// Linting a file itself does not compare it with its own ledger entry.
+	self := Source{Path: "tracked.md", Content: []byte("---\ntitle: Tracked Article\nslug: tracked-slug\n---\nBody\n")}
+	if d := findRule(Lint([]Source{self}, opts), "duplicate-slug"); d != nil {
+		t.Errorf("unexpected %v", d)
+	}
+
+	sources, err := FromStage(st)
+	if err != nil || len(sources) != 1 || sources[0].Path != "staged.md" {
+		t.Errorf("expected the untyped staged item to be linted, got %v, %v", sources, err)
+	}
+}
+
+func TestLintBrokenRelativeLink(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "img.png"), []byte("png"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	content := "---\ntitle: Links Article\n---\n\n![ok](img.png)\n[missing](other.md#intro)\n[web](https://example.com)\n```\n[code](nope.md)\n```\n"
+	src := Source{Path: filepath.Join(dir, "post.md"), Content: []byte(content)}
+	diags := Lint([]Source{src}, Options{})
+	if len(diags) != 1 || diags[0].Rule != "broken-link" {
+		t.Fatalf("expected a single broken-link diagnostic, got %v", diags)
+	}
+	if diags[0].Line != 6 {
+		t.Errorf("expected line 6, got %d", diags[0].Line)
+	}
+}
+
+func TestLintRulesAdjustLimitsAndSeverity(t *testing.T) {
+	src := Source{Path: "post.md", Content: []byte("---\ntitle: Short\ntags: [a, b, c]\ncanonical: not-a-url\n---\nBody\n")}
+
+	diags := Lint([]Source{src}, Options{Rules: Rules{MinTitleLength: 3, MaxTags: 2, Disable: []string{"url"}}})
+	if d := findRule(diags, "title"); d != nil {
+		t.Errorf("min_title_length 3 should accept %q, got %v", "Short", d)
+	}
+	if d := findRule(diags, "tags"); d == nil || !strings.Contains(d.Message, "at most 2") {
+		t.Errorf("expected max_tags 2 to be enforced, got %v", diags)
+	}
+	if d := findRule(diags, "url"); d != nil {
+		t.Errorf("disabled rule reported: %v", d)
+	}
+
+	diags = Lint([]Source{src}, Options{Rules: Rules{Warn: []string{"title", "url"}}})
+	if HasErrors(diags) {
+		t.Errorf("expected only warnings, got %v", diags)
+	}
+
+	if err := (Rules{Disable: []string{"no-such-rule"}}).Validate(); err == nil {
+		t.Error("expected an unknown rule name to be rejected")
+	}
+}
@@ -0,0 +1,116 @@
+package lint
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// Collect reads the markdown files named by paths. Directories are walked
+// recursively, skipping hidden directories such as .git and .hashnode.
+func Collect(paths []string) ([]Source, error) {
+	var sources []Source
+	seen := make(map[string]bool)
+	addFile := func(p string) error {
+		key := state.NormalizePath(p)
+		if seen[key] {
+			return nil
+		}
+		seen[key] = true
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", p, err)
+		}
+		sources = append(sources, Source{Path: key, Content: content})
+		return nil
+	}
+
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, fmt.Errorf("path does not exist: %s", p)
+		}
+		if !info.IsDir() {
+			switch state.ItemTypeForPath(state.NormalizePath(p)) {
+			case state.TypeRedirect, state.TypeWebhook:
+				continue
+			}
+			if err := addFile(p); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		err = state.WalkContent(p, func(path string, d os.DirEntry) error {
+			if !isMarkdown(path) {
+				return nil
+			}
+			return addFile(path)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Slice(sources, func(i, j int) bool { return sources[i].Path < sources[j].Path })
+	return sources, nil
+}
+
+// FromStage returns the staged snapshot content of every article or page
+// staged for modification. Items without a readable snapshot fall back to the
+// working file.
+func FromStage(st *state.Stage) ([]Source, error) {
+	var sources []Source
+	for key, it := range st.Items {
+		if (!isArticle(it) && it.Type != state.TypePage) || it.Operation != state.OpModify {
+			continue
+		}
+		content, err := stagedContent(key, it)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, Source{Path: key, Content: content})
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i].Path < sources[j].Path })
+	return sources, nil
+}
+
+// isArticle reports whether a staged item is an article; items staged before
+// types were recorded have an empty Type.
+func isArticle(it state.StagedItem) bool {
+	return it.Type == "" || it.Type == state.TypeArticle
+}
+
+// stagedContent reads the snapshot of a staged item, falling back to the
+// working file when the snapshot is missing.
+func stagedContent(key string, it state.StagedItem) ([]byte, error) {
+	var content []byte
+	var err error
+	if it.Snapshot != "" {
+		content, err = state.NewSnapshotStore().Get(it.Snapshot)
+	}
+	if content == nil {
+		content, err = os.ReadFile(absPath(key))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read content for %s: %w", key, err)
+	}
+	return content, nil
+}
+
+// absPath resolves a repository-relative path against the project root.
+func absPath(key string) string {
+	fsPath := filepath.FromSlash(key)
+	if !filepath.IsAbs(fsPath) {
+		fsPath = filepath.Join(state.ProjectRootOrCwd(), fsPath)
+	}
+	return fsPath
+}
+
+func isMarkdown(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".md" || ext == ".markdown"
+}
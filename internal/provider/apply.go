@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"adil-adysh/hashnode-cli/internal/diff"
+)
+
+// Result summarises an apply run.
+type Result struct {
+	Applied     int             // Items created, updated or deleted
+	Unsupported []diff.PlanItem // Items the API cannot apply; callers keep them staged
+}
+
+// Apply executes the plan items in order, dispatching each to its provider.
+// With env.Parallel above one, the items of each dependency level run
+// concurrently, at most env.Parallel at a time. It stops at the first failed
+// item (or level); changes made until then are recorded in env.Sum, which
+// the caller saves.
+func Apply(env *Env, plan []diff.PlanItem) (*Result, error) {
+	res := &Result{}
+	env.mu.Lock()
+	defer env.mu.Unlock()
+	if env.Parallel <= 1 {
+		for _, it := range plan {
+			if err := applyItem(env, it, res); err != nil {
+				return res, err
+			}
+		}
+		return res, nil
+	}
+	for _, level := range levels(plan) {
+		if err := applyLevel(env, level, res); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// applyLevel runs independent items concurrently. Each worker holds the env
+// lock except during API calls. Once an item fails no further items start.
+// The caller holds the lock.
+func applyLevel(env *Env, level []diff.PlanItem, res *Result) error {
+	errs := make([]error, len(level))
+	failed := false
+	sem := make(chan struct{}, env.Parallel)
+	var wg sync.WaitGroup
+
+	env.mu.Unlock()
+	for i, it := range level {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			env.mu.Lock()
+			defer env.mu.Unlock()
+			if failed {
+				return
+			}
+			if errs[i] = applyItem(env, it, res); errs[i] != nil {
+				failed = true
+			}
+		}()
+	}
+	wg.Wait()
+	env.mu.Lock()
+	return errors.Join(errs...)
+}
+
+// applyItem applies one plan item. The caller holds the env lock.
+func applyItem(env *Env, it diff.PlanItem, res *Result) error {
+	if it.Type == diff.ActionSkip {
+		return nil
+	}
+	p := For(KindOf(it))
+	if p == nil {
+		return fmt.Errorf("no provider for %s", RefOf(it))
+	}
+	var err error
+	switch it.Type {
+	case diff.ActionCreate:
+		err = p.Create(env, it)
+	case diff.ActionUpdate:
+		err = p.Update(env, it)
+	case diff.ActionDelete:
+		err = p.Delete(env, it)
+	}
+	if errors.Is(err, ErrUnsupported) {
+		env.logf("warning: %s %s not applied: %v; it stays staged\n", strings.ToLower(string(it.Type)), RefOf(it), err)
+		res.Unsupported = append(res.Unsupported, it)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	res.Applied++
+	return nil
+}
@@ -0,0 +1,394 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+
+	"adil-adysh/hashnode-cli/internal/api"
+	"adil-adysh/hashnode-cli/internal/applyutil"
+	"adil-adysh/hashnode-cli/internal/diff"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// articles manages markdown posts tracked in Sum.Articles.
+type articles struct{}
+
+func (articles) Kind() state.ItemType { return state.TypeArticle }
+
+// Registry builds the diff registry from the ledger's articles.
+func Registry(s *state.Sum) []diff.RegistryEntry {
+	var entries []diff.RegistryEntry
+	if s == nil {
+		return entries
+	}
+	for path, a := range s.Articles {
+		entries = append(entries, diff.RegistryEntry{
+			MarkdownPath: path,
+			RemotePostID: a.PostID,
+			DraftID:      a.DraftID,
+			Checksum:     a.Checksum,
+			Title:        a.Title,
+			Slug:         a.Slug,
+		})
+	}
+	return entries
+}
+
+func (articles) Diff(env *Env) ([]diff.PlanItem, error) {
+	repo := env.repo()
+	opts := diff.PlanOptions{RenameThreshold: repo.Plan.RenameThreshold, Frontmatter: repo.FrontmatterReader()}
+	return diff.GeneratePlanWithOptions(Registry(env.Sum), env.Stage, opts), nil
+}
+
+// Dependencies orders a post after the series named in its frontmatter, so
+// a series declared in the same apply exists before the post references it.
+func (articles) Dependencies(env *Env, it diff.PlanItem) []Ref {
+	if it.Type == diff.ActionDelete {
+		return nil
+	}
+	fm, _, err := applyutil.LoadContentForPath(env.repo().FrontmatterReader(), env.Stage, it.Path)
+	if err != nil || fm == nil || fm.Series == "" {
+		return nil
+	}
+	slug := state.SeriesSlug(fm.Series)
+	if se, ok := env.Sum.FindSeries(fm.Series); ok {
+		slug = se.Slug
+	}
+	return []Ref{{Kind: state.TypeSeries, Key: slug}}
+}
+
+// articleBySlug returns the path of the article published under slug. Slugs
+// come from the ledger, overridden by the frontmatter of staged articles.
+func (e *Env) articleBySlug(slug string) (string, bool) {
+	if e.slugs == nil {
+		e.slugs = make(map[string]string)
+		if e.Sum != nil {
+			for path, a := range e.Sum.Articles {
+				if a.Slug != "" {
+					e.slugs[a.Slug] = path
+				}
+			}
+		}
+		if e.Stage != nil {
+			for path, item := range e.Stage.Items {
+				if (item.Type != "" && item.Type != state.TypeArticle) || item.Operation == state.OpDelete {
+					continue
+				}
+				if fm, _, err := applyutil.LoadContentForPath(e.repo().FrontmatterReader(), e.Stage, path); err == nil && fm != nil && fm.Slug != "" {
+					e.slugs[fm.Slug] = path
+				}
+			}
+		}
+	}
+	path, ok := e.slugs[slug]
+	return path, ok
+}
+
+// publishContent applies repo conventions to an article on its way to the
+// API: frontmatter.defaults fill unset fields and relative image links are
+// rewritten against images.base_url. The file and its checksum are unchanged.
+func (e *Env) publishContent(path string, fm *state.Frontmatter, body string) (*state.Frontmatter, string) {
+	repo := e.repo()
+	return fm.WithDefaults(repo.Frontmatter.Defaults), applyutil.RewriteImageLinks(body, state.NormalizePath(path), repo.Images.BaseURL)
+}
+
+func (articles) Create(env *Env, it diff.PlanItem) error {
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	s, st := env.Sum, env.Stage
+	np := state.NormalizePath(it.Path)
+	fm, content, err := applyutil.LoadContentForPath(env.repo().FrontmatterReader(), st, it.Path)
+	if err != nil {
+		return err
+	}
+
+	// Resolve title using centralized function
+	title, _ := state.ResolveTitleForPath(it.Path, s, st)
+	if title == "" {
+		return fmt.Errorf("no title found for %s", it.Path)
+	}
+
+	pubFM, pubBody := env.publishContent(it.Path, fm, content)
+	var newID, pubSlug string
+	var remoteAt *time.Time
+	if draftID := s.Articles[np].DraftID; draftID != "" {
+		// An imported draft is published as itself, not as a second post
+		newID, pubSlug, remoteAt, err = publishDraft(env, client, draftID, title, pubFM, pubBody)
+	} else {
+		newID, pubSlug, remoteAt, err = publishPost(env, client, title, pubFM, pubBody)
+	}
+	if err != nil {
+		if newID != "" {
+			// The post exists; an empty checksum makes the next plan update it
+			s.SetArticleWithTitle(np, newID, "", pubSlug, title)
+		}
+		return fmt.Errorf("publish failed for %s: %w", it.Path, err)
+	}
+
+	var checksum string
+	if si, ok := st.Items[np]; ok && si.Checksum != "" {
+		checksum = si.Checksum
+	} else {
+		checksum = state.ChecksumFromContent([]byte(content))
+	}
+
+	// Optionally make the file carry its own identity (hashnode.yml apply.write_identity)
+	if env.repo().Apply.WriteIdentity {
+		if newChecksum, werr := applyutil.WriteIdentity(st, it.Path, newID, pubSlug); werr != nil {
+			env.logf("warning: could not write hashnode_id into %s: %v\n", it.Path, werr)
+		} else {
+			checksum = newChecksum
+		}
+	}
+	s.SetArticleWithTitle(np, newID, checksum, pubSlug, title)
+	s.RecordPublish(np, checksum, remoteAt)
+	env.logf("Created post %s -> %s\n", it.Path, newID)
+	return nil
+}
+
+// publishPost creates a new post and returns its ID, slug and remote
+// timestamp.
+func publishPost(env *Env, client graphql.Client, title string, fm *state.Frontmatter, body string) (string, string, *time.Time, error) {
+	input := api.PublishPostInput{Title: title, PublicationId: env.Sum.Blog.PublicationID, ContentMarkdown: body}
+	applyutil.ApplyFrontmatterToPublishInput(&input, fm, env.Sum)
+	var resp *api.PublishPostResponse
+	err := env.remote(func() (err error) {
+		resp, err = api.PublishPost(env.ctx(), client, input)
+		return err
+	})
+	if err != nil {
+		return "", "", nil, err
+	}
+	if resp == nil || resp.PublishPost.Post == nil || resp.PublishPost.Post.Id == "" {
+		return "", "", nil, fmt.Errorf("publish returned no id")
+	}
+	post := resp.PublishPost.Post
+	remoteAt := post.UpdatedAt
+	if remoteAt == nil && !post.PublishedAt.IsZero() {
+		remoteAt = &post.PublishedAt
+	}
+	return post.Id, post.Slug, remoteAt, nil
+}
+
+// publishDraft publishes a Hashnode draft, which turns it into a post, then
+// updates that post with the local content. When only the update fails the
+// new post's ID is still returned so the caller can record it.
+func publishDraft(env *Env, client graphql.Client, draftID, title string, fm *state.Frontmatter, body string) (string, string, *time.Time, error) {
+	var resp *api.PublishDraftResponse
+	err := env.remote(func() (err error) {
+		resp, err = api.PublishDraft(env.ctx(), client, api.PublishDraftInput{DraftId: draftID})
+		return err
+	})
+	if err != nil {
+		return "", "", nil, fmt.Errorf("draft %s: %w", draftID, err)
+	}
+	if resp == nil || resp.PublishDraft.Post == nil || resp.PublishDraft.Post.Id == "" {
+		return "", "", nil, fmt.Errorf("publishing draft %s returned no post id", draftID)
+	}
+	post := resp.PublishDraft.Post
+
+	pubID := env.Sum.Blog.PublicationID
+	input := api.UpdatePostInput{Id: post.Id, ContentMarkdown: &body, Title: &title, PublicationId: &pubID}
+	applyutil.ApplyFrontmatterToUpdateInput(&input, fm, env.Sum)
+	var up *api.UpdatePostResponse
+	err = env.remote(func() (err error) {
+		up, err = api.UpdatePost(env.ctx(), client, input)
+		return err
+	})
+	if err != nil {
+		return post.Id, post.Slug, nil, fmt.Errorf("draft %s was published as post %s but not updated: %w", draftID, post.Id, err)
+	}
+	slug := post.Slug
+	var remoteAt *time.Time
+	if up != nil && up.UpdatePost.Post != nil {
+		if up.UpdatePost.Post.Slug != "" {
+			slug = up.UpdatePost.Post.Slug
+		}
+		remoteAt = up.UpdatePost.Post.UpdatedAt
+	}
+	return post.Id, slug, remoteAt, nil
+}
+
+func (articles) Update(env *Env, it diff.PlanItem) error {
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	s, st := env.Sum, env.Stage
+	np := state.NormalizePath(it.Path)
+	oldPath := ""
+	if it.OldPath != "" && state.NormalizePath(it.OldPath) != np {
+		oldPath = state.NormalizePath(it.OldPath)
+	}
+
+	// The plan's remote ID wins: it may come from hashnode_id in frontmatter
+	le, ok := s.Articles[np]
+	if !ok && oldPath != "" {
+		le = s.Articles[oldPath]
+	}
+	remoteID := it.RemoteID
+	if remoteID == "" {
+		remoteID = le.PostID
+	}
+	if remoteID == "" {
+		// nothing to update (shouldn't happen)
+		return nil
+	}
+	// staleness check using new staged item schema
+	if si, ok := st.Items[np]; ok && state.IsStagingItemStale(si, it.Path) {
+		if !env.Yes {
+			return fmt.Errorf("staged content changed for %s; re-stage or rerun with --yes to force", it.Path)
+		}
+		env.logf("warning: forcing apply despite staged content changes for %s\n", it.Path)
+	}
+	// Load content from snapshot when available, otherwise disk
+	fm, content, err := applyutil.LoadContentForPath(env.repo().FrontmatterReader(), st, it.Path)
+	if err != nil {
+		return err
+	}
+
+	// Resolve title using centralized function
+	title, _ := state.ResolveTitleForPath(it.Path, s, st)
+	if title == "" {
+		return fmt.Errorf("no title found for %s", it.Path)
+	}
+
+	// perform update via API (include title)
+	if s.Blog.PublicationID == "" {
+		return fmt.Errorf("update failed for %s: publication id missing in ledger; run 'hashnode init'", it.Path)
+	}
+	pubID := s.Blog.PublicationID
+	pubFM, pubBody := env.publishContent(it.Path, fm, content)
+	input := api.UpdatePostInput{Id: remoteID, ContentMarkdown: &pubBody, Title: &title, PublicationId: &pubID}
+	applyutil.ApplyFrontmatterToUpdateInput(&input, pubFM, s)
+	var resp *api.UpdatePostResponse
+	err = env.remote(func() (err error) {
+		resp, err = api.UpdatePost(env.ctx(), client, input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("update failed for %s: %w", it.Path, err)
+	}
+
+	// Determine checksum to store
+	var checksum string
+	if stored, ok := st.Items[np]; ok && stored.Checksum != "" {
+		checksum = stored.Checksum
+	} else {
+		checksum = state.ChecksumFromContent([]byte(content))
+	}
+	// Record the slug the API settled on, else keep the ledger's
+	slug := le.Slug
+	if resp != nil && resp.UpdatePost.Post != nil && resp.UpdatePost.Post.Slug != "" {
+		slug = resp.UpdatePost.Post.Slug
+	}
+
+	var redirects []state.SlugRedirect
+	if it.OldSlug != "" && slug != it.OldSlug {
+		if env.RedirectSlugs || env.repo().Apply.RedirectSlugs {
+			redirects, err = redirectSlug(env, le.Redirects, it.OldSlug, slug)
+			if err != nil {
+				return fmt.Errorf("slug redirect for %s: %w", it.Path, err)
+			}
+			env.logf("↪️  Redirected /%s → /%s\n", it.OldSlug, slug)
+		} else {
+			env.logf("warning: slug of %s changed from %s to %s; old links break (use --redirect-slugs)\n", it.Path, it.OldSlug, slug)
+		}
+	}
+
+	if oldPath != "" {
+		s.MoveArticle(oldPath, np)
+	}
+	s.SetArticleWithTitle(np, remoteID, checksum, slug, title)
+	if redirects != nil {
+		entry := s.Articles[np]
+		entry.Redirects = redirects
+		s.Articles[np] = entry
+	}
+	var remoteAt *time.Time
+	if resp != nil && resp.UpdatePost.Post != nil {
+		remoteAt = resp.UpdatePost.Post.UpdatedAt
+	}
+	s.RecordPublish(np, checksum, remoteAt)
+	env.logf("Updated post %s -> %s\n", it.Path, remoteID)
+	return nil
+}
+
+func (articles) Delete(env *Env, it diff.PlanItem) error {
+	np := state.NormalizePath(it.Path)
+	remoteID := it.RemoteID
+	if remoteID == "" {
+		remoteID = env.Sum.Articles[np].PostID
+	}
+	if remoteID == "" {
+		// nothing to delete
+		return nil
+	}
+	it.RemoteID = remoteID
+	if err := env.confirmDelete(it); err != nil {
+		return err
+	}
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	if err := env.remote(func() error {
+		_, err := api.RemovePost(env.ctx(), client, api.RemovePostInput{Id: remoteID})
+		return err
+	}); err != nil {
+		return fmt.Errorf("delete failed for %s (remote id=%s): %w", it.Path, remoteID, err)
+	}
+	env.Sum.RemoveArticle(np)
+	env.logf("Deleted post %s -> %s\n", it.Path, remoteID)
+	return nil
+}
+
+// redirectSlug creates a permanent redirect from oldSlug to newSlug and
+// returns the article's updated redirect list. Rules whose source is the new
+// slug are removed first, so reverting a slug change cannot create a loop.
+func redirectSlug(env *Env, existing []state.SlugRedirect, oldSlug, newSlug string) ([]state.SlugRedirect, error) {
+	client, err := env.client()
+	if err != nil {
+		return nil, err
+	}
+	s := env.Sum
+	pubID := s.Blog.PublicationID
+	redirects := []state.SlugRedirect{}
+	for _, r := range existing {
+		if r.From != newSlug {
+			redirects = append(redirects, r)
+			continue
+		}
+		if err := env.remote(func() error {
+			_, err := api.RemoveRedirectionRule(env.ctx(), client, api.RemoveRedirectionRuleInput{Id: r.RuleID, PublicationId: pubID})
+			return err
+		}); err != nil {
+			return nil, fmt.Errorf("remove redirect from /%s: %w", r.From, err)
+		}
+	}
+
+	// Destination is a URL; the publication URL is recorded at init
+	destination := "/" + newSlug
+	if base := strings.TrimRight(s.Blog.PublicationSlug, "/"); strings.HasPrefix(base, "http") {
+		destination = base + destination
+	}
+	var resp *api.CreateRedirectionRuleResponse
+	err = env.remote(func() (err error) {
+		resp, err = api.CreateRedirectionRule(env.ctx(), client, api.CreateRedirectionRuleInput{
+			PublicationId: pubID,
+			Source:        "/" + oldSlug,
+			Destination:   destination,
+			Type:          api.HttpRedirectionTypePermanent,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(redirects, state.SlugRedirect{From: oldSlug, RuleID: resp.CreateRedirectionRule.RedirectionRule.Id}), nil
+}
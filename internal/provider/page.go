@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"adil-adysh/hashnode-cli/internal/diff"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// pages manages static pages under pages/. The public API can read pages
+// (see `hn import`) but has no mutations for them, so changes are planned
+// and then left staged.
+type pages struct{}
+
+func (pages) Kind() state.ItemType { return state.TypePage }
+
+func (pages) Diff(env *Env) ([]diff.PlanItem, error) {
+	var tracked map[string]state.PageSum
+	if env.Sum != nil {
+		tracked = env.Sum.Pages
+	}
+	return diff.PlanPages(tracked, env.Stage, env.repo().FrontmatterReader()), nil
+}
+
+func (pages) Dependencies(env *Env, it diff.PlanItem) []Ref { return nil }
+
+func (pages) Create(env *Env, it diff.PlanItem) error { return ErrUnsupported }
+
+func (pages) Update(env *Env, it diff.PlanItem) error { return ErrUnsupported }
+
+func (pages) Delete(env *Env, it diff.PlanItem) error { return ErrUnsupported }
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"adil-adysh/hashnode-cli/internal/diff"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// BuildPlan asks every provider for its staged changes and orders them so
+// each item follows the resources it depends on. Provider errors are joined;
+// the items of the other providers are still returned.
+func BuildPlan(env *Env) ([]diff.PlanItem, error) {
+	var items []diff.PlanItem
+	var errs []error
+	for _, p := range All() {
+		got, err := p.Diff(env)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", strings.ToLower(string(p.Kind())), err))
+			continue
+		}
+		items = append(items, got...)
+	}
+	ordered, err := Order(env, items)
+	if err != nil {
+		return items, errors.Join(append(errs, err)...)
+	}
+	return ordered, errors.Join(errs...)
+}
+
+// CycleError reports resources whose dependencies form a loop.
+type CycleError struct {
+	Cycle []Ref // The loop, first resource repeated at the end
+}
+
+func (e *CycleError) Error() string {
+	parts := make([]string, len(e.Cycle))
+	for i, r := range e.Cycle {
+		parts[i] = r.String()
+	}
+	return "dependency cycle: " + strings.Join(parts, " → ")
+}
+
+// Order sorts items topologically over their providers' dependency edges
+// (Kahn's algorithm) and records the edges in each item's DependsOn. When
+// several items are ready they go in the fixed plan order (deletes, creates,
+// updates, then skips; see diff.ActionRank), then by provider and key, so
+// the same plan always yields the same order. Edges to resources that are
+// not in the plan are ignored.
+func Order(env *Env, items []diff.PlanItem) ([]diff.PlanItem, error) {
+	byRef := make(map[Ref][]int)
+	for i, it := range items {
+		ref := RefOf(it)
+		byRef[ref] = append(byRef[ref], i)
+	}
+
+	indegree := make([]int, len(items))
+	deps := make([][]int, len(items))
+	dependents := make([][]int, len(items))
+	for i := range items {
+		p := For(KindOf(items[i]))
+		if p == nil {
+			continue
+		}
+		items[i].DependsOn = nil
+		for _, dep := range p.Dependencies(env, items[i]) {
+			for _, j := range byRef[dep] {
+				if j == i {
+					continue
+				}
+				deps[i] = append(deps[i], j)
+				dependents[j] = append(dependents[j], i)
+				indegree[i]++
+				items[i].DependsOn = append(items[i].DependsOn, dep.String())
+			}
+		}
+	}
+
+	before := func(a, b int) bool { return readyBefore(items[a], items[b]) }
+	var ready []int
+	for i := range items {
+		if indegree[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+	sort.SliceStable(ready, func(x, y int) bool { return before(ready[x], ready[y]) })
+	ordered := make([]diff.PlanItem, 0, len(items))
+	for len(ready) > 0 {
+		i := ready[0]
+		ready = ready[1:]
+		ordered = append(ordered, items[i])
+		for _, j := range dependents[i] {
+			if indegree[j]--; indegree[j] == 0 {
+				ready = append(ready, j)
+			}
+		}
+		sort.SliceStable(ready, func(x, y int) bool { return before(ready[x], ready[y]) })
+	}
+
+	if len(ordered) < len(items) {
+		return nil, &CycleError{Cycle: findCycle(items, deps, indegree)}
+	}
+	return ordered, nil
+}
+
+// readyBefore is the tie-break between items with no dependency between them.
+func readyBefore(a, b diff.PlanItem) bool {
+	if ra, rb := diff.ActionRank(a.Type), diff.ActionRank(b.Type); ra != rb {
+		return ra < rb
+	}
+	if ka, kb := kindRank(KindOf(a)), kindRank(KindOf(b)); ka != kb {
+		return ka < kb
+	}
+	return RefOf(a).Key < RefOf(b).Key
+}
+
+// kindRank is the position of kind's provider in All.
+func kindRank(kind state.ItemType) int {
+	for i, p := range All() {
+		if p.Kind() == kind {
+			return i
+		}
+	}
+	return len(All())
+}
+
+// findCycle walks dependencies among the items Kahn's algorithm could not
+// place. Each of them still waits on another such item, so the walk must
+// revisit one; the loop runs from its first visit.
+func findCycle(items []diff.PlanItem, deps [][]int, indegree []int) []Ref {
+	start := 0
+	for indegree[start] == 0 {
+		start++
+	}
+	seen := make(map[int]int)
+	var walk []int
+	for i := start; ; {
+		if at, ok := seen[i]; ok {
+			walk = append(walk[at:], i)
+			break
+		}
+		seen[i] = len(walk)
+		walk = append(walk, i)
+		for _, j := range deps[i] {
+			if indegree[j] > 0 {
+				i = j
+				break
+			}
+		}
+	}
+	cycle := make([]Ref, len(walk))
+	for k, i := range walk {
+		cycle[k] = RefOf(items[i])
+	}
+	return cycle
+}
+
+// levels groups an ordered plan by dependency depth: every item lands in a
+// later level than the items it depends on, so the items of one level can
+// run concurrently. Each depth is further split by action (see
+// diff.ActionRank), so deletes finish before creates claim the slugs and
+// URLs they free.
+func levels(plan []diff.PlanItem) [][]diff.PlanItem {
+	depth := make(map[string]int)
+	var out [][]diff.PlanItem
+	for _, it := range plan {
+		d := 0
+		for _, dep := range it.DependsOn {
+			if n, ok := depth[dep]; ok && n+1 > d {
+				d = n + 1
+			}
+		}
+		ref := RefOf(it).String()
+		if n, ok := depth[ref]; !ok || d > n {
+			depth[ref] = d
+		}
+		for len(out) <= d {
+			out = append(out, nil)
+		}
+		out[d] = append(out[d], it)
+	}
+	var split [][]diff.PlanItem
+	for _, level := range out {
+		byRank := make(map[int][]diff.PlanItem)
+		for _, it := range level {
+			r := diff.ActionRank(it.Type)
+			byRank[r] = append(byRank[r], it)
+		}
+		for r := 0; r <= diff.ActionRank(diff.ActionSkip); r++ {
+			if len(byRank[r]) > 0 {
+				split = append(split, byRank[r])
+			}
+		}
+	}
+	return split
+}
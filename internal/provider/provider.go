@@ -0,0 +1,147 @@
+// Package provider adapts each resource kind (articles, series, static pages,
+// redirects and webhooks) to one interface, so plan and apply run a single
+// pipeline instead of one hand-written branch per kind.
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Khan/genqlient/graphql"
+
+	"adil-adysh/hashnode-cli/internal/config"
+	"adil-adysh/hashnode-cli/internal/diff"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// ErrUnsupported is returned by providers for operations the Hashnode API
+// does not offer. Apply reports the item and leaves it staged.
+var ErrUnsupported = errors.New("not supported by the Hashnode API")
+
+// Ref identifies one resource: its kind and key (file path, series slug,
+// redirect source or webhook name).
+type Ref struct {
+	Kind state.ItemType
+	Key  string
+}
+
+func (r Ref) String() string {
+	return strings.ToLower(string(r.Kind)) + " " + r.Key
+}
+
+// Env is the state shared by the providers during one plan or apply run.
+// Providers record results in Sum; the caller saves it.
+type Env struct {
+	Ctx    context.Context
+	Client graphql.Client // Nil when no token is configured; remote reads fail
+	Sum    *state.Sum
+	Stage  *state.Stage
+	Repo   *config.RepoConfig
+
+	Yes           bool // Confirms destructive changes (apply --yes)
+	RedirectSlugs bool // Redirect changed article slugs (apply --redirect-slugs)
+	Parallel      int  // Items applied concurrently per dependency level (apply --parallel); <= 1 is sequential
+
+	Logf func(format string, args ...interface{}) // Progress output; defaults to fmt.Printf
+
+	// mu serializes ledger, stage and output access while Apply runs; it is
+	// released only around API calls (see remote).
+	mu    sync.Mutex
+	slugs map[string]string // Article path by slug, built on first use
+}
+
+// remote runs an API call with the env lock released, so parallel applies
+// overlap only their network I/O.
+func (e *Env) remote(call func() error) error {
+	e.mu.Unlock()
+	defer e.mu.Lock()
+	return call()
+}
+
+func (e *Env) logf(format string, args ...interface{}) {
+	if e.Logf != nil {
+		e.Logf(format, args...)
+		return
+	}
+	fmt.Printf(format, args...)
+}
+
+func (e *Env) ctx() context.Context {
+	if e.Ctx != nil {
+		return e.Ctx
+	}
+	return context.Background()
+}
+
+func (e *Env) repo() *config.RepoConfig {
+	if e.Repo != nil {
+		return e.Repo
+	}
+	return &config.RepoConfig{}
+}
+
+func (e *Env) client() (graphql.Client, error) {
+	if e.Client == nil {
+		return nil, fmt.Errorf("no token configured; run 'hashnode init'")
+	}
+	return e.Client, nil
+}
+
+// confirmDelete refuses destructive changes unless the run was confirmed.
+func (e *Env) confirmDelete(it diff.PlanItem) error {
+	if e.Yes {
+		return nil
+	}
+	return fmt.Errorf("deletion required for %s (remote id=%s). Re-run with --yes to confirm deletions", RefOf(it), it.RemoteID)
+}
+
+// Provider manages one resource kind.
+type Provider interface {
+	Kind() state.ItemType
+	// Diff plans the staged changes of this kind against the ledger or remote.
+	Diff(env *Env) ([]diff.PlanItem, error)
+	Create(env *Env, it diff.PlanItem) error
+	Update(env *Env, it diff.PlanItem) error
+	Delete(env *Env, it diff.PlanItem) error
+	// Dependencies returns the resources that must be applied before it.
+	Dependencies(env *Env, it diff.PlanItem) []Ref
+}
+
+// All returns every provider in planning order.
+func All() []Provider {
+	return []Provider{series{}, articles{}, pages{}, redirects{}, webhooks{}}
+}
+
+// For returns the provider for kind, or nil.
+func For(kind state.ItemType) Provider {
+	if kind == "" {
+		kind = state.TypeArticle
+	}
+	for _, p := range All() {
+		if p.Kind() == kind {
+			return p
+		}
+	}
+	return nil
+}
+
+// KindOf returns the resource kind of a plan item; articles leave Kind empty.
+func KindOf(it diff.PlanItem) state.ItemType {
+	if it.Kind == "" {
+		return state.TypeArticle
+	}
+	return it.Kind
+}
+
+// RefOf returns the resource a plan item changes.
+func RefOf(it diff.PlanItem) Ref {
+	kind := KindOf(it)
+	switch kind {
+	case state.TypeRedirect, state.TypeWebhook:
+		return Ref{Kind: kind, Key: it.Title}
+	}
+	return Ref{Kind: kind, Key: it.Path}
+}
@@ -0,0 +1,452 @@
+package provider_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+
+	"adil-adysh/hashnode-cli/internal/config"
+	"adil-adysh/hashnode-cli/internal/diff"
+	"adil-adysh/hashnode-cli/internal/provider"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// setupProject creates a temp project root and chdirs into it.
+func setupProject(t *testing.T) string {
+	t.Helper()
+	tempDir := t.TempDir()
+	origDir, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(origDir)
+		state.ResetProjectRootCache()
+	})
+	if err := os.Chdir(tempDir); err != nil {
+		t.Fatalf("chdir failed: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Join(tempDir, ".hashnode"), 0755); err != nil {
+		t.Fatalf("mkdir .hashnode failed: %v", err)
+	}
+	state.ResetProjectRootCache()
+	return tempDir
+}
+
+func stage(t *testing.T, dir, path, content string) {
+	t.Helper()
+	abs := filepath.Join(dir, filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(abs), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(abs, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := state.StageAdd(abs); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuildPlanCoversEveryKind(t *testing.T) {
+	dir := setupProject(t)
+	stage(t, dir, "posts/hello.md", "---\ntitle: Hello\n---\nBody\n")
+	stage(t, dir, "pages/about.md", "---\ntitle: About\n---\nMe\n")
+	stage(t, dir, state.WebhooksFile, "webhooks:\n  - name: deploy\n    url: https://ci.example.com\n    events: [POST_UPDATED]\n    secret_env: S\n")
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	st.Items["go"] = state.StagedItem{Type: state.TypeSeries, Key: "go", Operation: state.OpModify}
+	sum := &state.Sum{Series: map[string]state.SeriesEntry{"go": {Name: "Go", Slug: "go"}}}
+
+	plan, err := provider.BuildPlan(&provider.Env{Sum: sum, Stage: st})
+	if err != nil {
+		t.Fatalf("BuildPlan failed: %v", err)
+	}
+	kinds := make(map[state.ItemType]diff.ActionType)
+	for _, it := range plan {
+		kinds[provider.KindOf(it)] = it.Type
+	}
+	for _, kind := range []state.ItemType{state.TypeArticle, state.TypeSeries, state.TypePage, state.TypeWebhook} {
+		if kinds[kind] != diff.ActionCreate {
+			t.Errorf("%s: expected CREATE, got %q", kind, kinds[kind])
+		}
+	}
+}
+
+func TestBuildPlanReportsRemoteErrors(t *testing.T) {
+	dir := setupProject(t)
+	stage(t, dir, "posts/hello.md", "---\ntitle: Hello\n---\nBody\n")
+	stage(t, dir, state.RedirectsFile, "redirects:\n  - source: /a\n    destination: /b\n")
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Without a client the redirects cannot be diffed; articles still plan
+	plan, err := provider.BuildPlan(&provider.Env{Stage: st})
+	if err == nil || !strings.Contains(err.Error(), "redirect") {
+		t.Errorf("expected a redirect error, got %v", err)
+	}
+	if len(plan) != 1 || plan[0].Path != "posts/hello.md" {
+		t.Errorf("unexpected plan: %+v", plan)
+	}
+}
+
+func TestApplyKeepsUnsupportedItems(t *testing.T) {
+	setupProject(t)
+	env := &provider.Env{Sum: &state.Sum{}, Stage: &state.Stage{Items: map[string]state.StagedItem{}}, Logf: t.Logf}
+	plan := []diff.PlanItem{
+		{Type: diff.ActionUpdate, Kind: state.TypePage, Path: "pages/about.md"},
+		{Type: diff.ActionSkip, Path: "posts/hello.md"},
+	}
+	res, err := provider.Apply(env, plan)
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	if res.Applied != 0 || len(res.Unsupported) != 1 {
+		t.Errorf("unexpected result: %+v", res)
+	}
+}
+
+func TestApplyDeleteNeedsConfirmation(t *testing.T) {
+	setupProject(t)
+	env := &provider.Env{Sum: &state.Sum{}, Stage: &state.Stage{Items: map[string]state.StagedItem{}}, Logf: t.Logf}
+	plan := []diff.PlanItem{{Type: diff.ActionDelete, Path: "posts/old.md", RemoteID: "post-1"}}
+	if _, err := provider.Apply(env, plan); err == nil || !strings.Contains(err.Error(), "--yes") {
+		t.Errorf("expected confirmation error, got %v", err)
+	}
+}
+
+func TestOrderPlacesArticlesAfterTheirSeries(t *testing.T) {
+	dir := setupProject(t)
+	stage(t, dir, "posts/intro.md", "---\ntitle: Intro\nseries: Go Basics\n---\nBody\n")
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := &state.Sum{Series: map[string]state.SeriesEntry{"go-basics": {Name: "Go Basics", Slug: "go-basics"}}}
+	items := []diff.PlanItem{
+		{Type: diff.ActionCreate, Path: "posts/intro.md"},
+		{Type: diff.ActionCreate, Kind: state.TypeSeries, Path: "go-basics", Title: "Go Basics"},
+	}
+
+	ordered, err := provider.Order(&provider.Env{Sum: sum, Stage: st}, items)
+	if err != nil {
+		t.Fatalf("Order failed: %v", err)
+	}
+	if ordered[0].Kind != state.TypeSeries || ordered[1].Path != "posts/intro.md" {
+		t.Fatalf("expected series first, got %+v", ordered)
+	}
+	if got := ordered[1].DependsOn; len(got) != 1 || got[0] != "series go-basics" {
+		t.Errorf("unexpected DependsOn: %v", got)
+	}
+}
+
+func TestOrderPlacesRedirectsAfterTheirTarget(t *testing.T) {
+	dir := setupProject(t)
+	stage(t, dir, "posts/new.md", "---\ntitle: New\nslug: new-post\n---\nBody\n")
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := &state.Sum{Blog: state.BlogEntry{PublicationSlug: "https://blog.example.com"}}
+	items := []diff.PlanItem{
+		{Type: diff.ActionCreate, Kind: state.TypeRedirect, Title: "/old", Redirect: &state.Redirect{Source: "/old", Destination: "https://blog.example.com/new-post/"}},
+		{Type: diff.ActionCreate, Kind: state.TypeRedirect, Title: "/away", Redirect: &state.Redirect{Source: "/away", Destination: "https://elsewhere.example.com/new-post"}},
+		{Type: diff.ActionCreate, Path: "posts/new.md"},
+	}
+
+	ordered, err := provider.Order(&provider.Env{Sum: sum, Stage: st}, items)
+	if err != nil {
+		t.Fatalf("Order failed: %v", err)
+	}
+	var got []string
+	for _, it := range ordered {
+		got = append(got, provider.RefOf(it).String()+" "+fmt.Sprint(it.DependsOn))
+	}
+	want := "article posts/new.md [], redirect /away [], redirect /old [article posts/new.md]"
+	if strings.Join(got, ", ") != want {
+		t.Errorf("order = %v, want %s", got, want)
+	}
+}
+
+// slowClient answers every request with an empty response after a delay and
+// records how many requests were in flight at once.
+type slowClient struct {
+	mu            sync.Mutex
+	active, peaks int
+}
+
+func (c *slowClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	c.mu.Lock()
+	c.active++
+	if c.active > c.peaks {
+		c.peaks = c.active
+	}
+	c.mu.Unlock()
+	time.Sleep(20 * time.Millisecond)
+	c.mu.Lock()
+	c.active--
+	c.mu.Unlock()
+	return nil
+}
+
+func TestApplyParallelOverlapsIndependentItems(t *testing.T) {
+	setupProject(t)
+	client := &slowClient{}
+	sum := &state.Sum{}
+	var plan []diff.PlanItem
+	for i := 0; i < 6; i++ {
+		path := fmt.Sprintf("posts/p%d.md", i)
+		sum.SetArticle(path, fmt.Sprintf("post-%d", i), "c", "")
+		plan = append(plan, diff.PlanItem{Type: diff.ActionDelete, Path: path})
+	}
+	env := &provider.Env{Client: client, Sum: sum, Stage: &state.Stage{Items: map[string]state.StagedItem{}}, Yes: true, Parallel: 3, Logf: t.Logf}
+
+	res, err := provider.Apply(env, plan)
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	if res.Applied != 6 || len(sum.Articles) != 0 {
+		t.Errorf("expected every post deleted, got %+v with %d left", res, len(sum.Articles))
+	}
+	if client.peaks < 2 || client.peaks > 3 {
+		t.Errorf("expected 2-3 requests in flight, peaked at %d", client.peaks)
+	}
+}
+
+// slugClient deletes slowly and counts creates sent before every delete
+// finished.
+type slugClient struct {
+	mu       sync.Mutex
+	pending  int // Deletes not yet finished
+	overlaps int
+}
+
+func (c *slugClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	switch req.OpName {
+	case "RemovePost":
+		time.Sleep(30 * time.Millisecond)
+		c.mu.Lock()
+		c.pending--
+		c.mu.Unlock()
+		return nil
+	case "PublishPost":
+		c.mu.Lock()
+		if c.pending > 0 {
+			c.overlaps++
+		}
+		c.mu.Unlock()
+		return json.Unmarshal([]byte(`{"publishPost":{"post":{"id":"post-new","slug":"hello"}}}`), resp.Data)
+	}
+	return fmt.Errorf("unexpected %s", req.OpName)
+}
+
+func TestApplyParallelDeletesBeforeCreatingTheSameSlug(t *testing.T) {
+	dir := setupProject(t)
+	stage(t, dir, "posts/new.md", "---\ntitle: Hello\nslug: hello\n---\nBody\n")
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := &state.Sum{Blog: state.BlogEntry{PublicationID: "pub-1"}}
+	sum.SetArticle("posts/old.md", "post-old", "c", "hello")
+	plan := []diff.PlanItem{
+		{Type: diff.ActionDelete, Path: "posts/old.md", RemoteID: "post-old"},
+		{Type: diff.ActionCreate, Path: "posts/new.md"},
+	}
+	client := &slugClient{pending: 1}
+	env := &provider.Env{Client: client, Sum: sum, Stage: st, Yes: true, Parallel: 4, Logf: t.Logf}
+
+	if _, err := provider.Apply(env, plan); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	if client.overlaps != 0 {
+		t.Error("a create started while a delete was still running")
+	}
+	if got := sum.Articles["posts/new.md"].PostID; got != "post-new" {
+		t.Errorf("create not recorded: %+v", sum.Articles)
+	}
+}
+
+// recordingClient keeps the variables of every request and fails it, so
+// tests can inspect what would have been sent.
+type recordingClient struct {
+	vars []string
+}
+
+func (c *recordingClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	b, _ := json.Marshal(req.Variables)
+	c.vars = append(c.vars, string(b))
+	return fmt.Errorf("recorded")
+}
+
+func TestUpdateAppliesRepoDefaultsAndImageBaseURL(t *testing.T) {
+	dir := setupProject(t)
+	content := "---\ntitle: Hello world\n---\n![diagram](img/flow.png)\n![logo](https://example.com/l.png)\n"
+	stage(t, dir, "posts/hello.md", content)
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := &state.Sum{Blog: state.BlogEntry{PublicationID: "pub-1"}}
+	sum.SetArticle("posts/hello.md", "post-1", "old", "hello-world")
+
+	repo := &config.RepoConfig{
+		Frontmatter: config.FrontmatterConfig{Defaults: &state.Frontmatter{Tags: []string{"go"}}},
+		Images:      config.ImagesConfig{BaseURL: "https://cdn.example.com/blog/"},
+	}
+	client := &recordingClient{}
+	env := &provider.Env{Client: client, Sum: sum, Stage: st, Repo: repo, Yes: true, Logf: t.Logf}
+	if _, err := provider.Apply(env, []diff.PlanItem{{Type: diff.ActionUpdate, Path: "posts/hello.md", RemoteID: "post-1"}}); err == nil {
+		t.Fatal("expected the recorded request to fail the apply")
+	}
+
+	if len(client.vars) != 1 {
+		t.Fatalf("expected one request, got %d", len(client.vars))
+	}
+	sent := client.vars[0]
+	for _, want := range []string{
+		`![diagram](https://cdn.example.com/blog/posts/img/flow.png)`,
+		`![logo](https://example.com/l.png)`,
+		`"slug":"go"`,
+	} {
+		if !strings.Contains(sent, want) {
+			t.Errorf("request does not contain %s: %s", want, sent)
+		}
+	}
+	if onDisk, _ := os.ReadFile(filepath.Join(dir, "posts", "hello.md")); string(onDisk) != content {
+		t.Errorf("the working file must not change, got %q", onDisk)
+	}
+}
+
+func TestImportedDraftsStayUnpublished(t *testing.T) {
+	dir := setupProject(t)
+	draft := "---\ntitle: Idea\nslug: idea\npublished: false\n---\nNot ready\n"
+	scheduled := fmt.Sprintf("---\ntitle: Soon\nslug: soon\npublished_at: %s\n---\nLater\n", time.Now().Add(48*time.Hour).UTC().Format(time.RFC3339))
+	sum := &state.Sum{Blog: state.BlogEntry{PublicationID: "pub-1"}}
+	sum.SetDraft("drafts/idea.md", "draft-1", state.ChecksumFromContent([]byte(draft)), "idea")
+	sum.SetDraft("drafts/soon.md", "draft-2", state.ChecksumFromContent([]byte(scheduled)), "soon")
+	stage(t, dir, "drafts/idea.md", draft)
+	stage(t, dir, "drafts/soon.md", scheduled)
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &recordingClient{}
+	env := &provider.Env{Client: client, Sum: sum, Stage: st, Yes: true, Logf: t.Logf}
+	plan, err := provider.BuildPlan(env)
+	if err != nil {
+		t.Fatalf("BuildPlan failed: %v", err)
+	}
+	for _, it := range plan {
+		if it.Type != diff.ActionSkip || !strings.Contains(it.Reason, "not published") {
+			t.Errorf("%s: expected an unpublished skip, got %s (%s)", it.Path, it.Type, it.Reason)
+		}
+	}
+
+	res, err := provider.Apply(env, plan)
+	if err != nil || res.Applied != 0 {
+		t.Fatalf("Apply = %+v, %v", res, err)
+	}
+	if len(client.vars) != 0 {
+		t.Errorf("expected no requests, sent %v", client.vars)
+	}
+	for _, p := range []string{"drafts/idea.md", "drafts/soon.md"} {
+		if got := sum.Articles[p]; got.PostID != "" || got.DraftID == "" {
+			t.Errorf("%s: ledger entry after apply = %+v", p, got)
+		}
+	}
+
+	// Publishing the draft in frontmatter creates the post
+	stage(t, dir, "drafts/idea.md", strings.Replace(draft, "published: false", "published: true", 1))
+	if env.Stage, err = state.LoadStage(); err != nil {
+		t.Fatal(err)
+	}
+	if plan, _ = provider.BuildPlan(env); !hasAction(plan, "drafts/idea.md", diff.ActionCreate) {
+		t.Errorf("expected a create once published, got %+v", plan)
+	}
+}
+
+func hasAction(plan []diff.PlanItem, path string, action diff.ActionType) bool {
+	for _, it := range plan {
+		if it.Path == path && it.Type == action {
+			return true
+		}
+	}
+	return false
+}
+
+// scriptedClient answers each operation with canned JSON data and records
+// the operations it was sent.
+type scriptedClient struct {
+	data map[string]string
+	ops  []string
+}
+
+func (c *scriptedClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	c.ops = append(c.ops, req.OpName)
+	data, ok := c.data[req.OpName]
+	if !ok {
+		return fmt.Errorf("unexpected %s", req.OpName)
+	}
+	return json.Unmarshal([]byte(data), resp.Data)
+}
+
+func TestPublishingAnImportedDraftUsesTheDraft(t *testing.T) {
+	dir := setupProject(t)
+	imported := "---\ntitle: Idea\nslug: idea\npublished: false\n---\nNot ready\n"
+	sum := &state.Sum{Blog: state.BlogEntry{PublicationID: "pub-1"}}
+	sum.SetDraft("drafts/idea.md", "draft-1", state.ChecksumFromContent([]byte(imported)), "idea")
+	stage(t, dir, "drafts/idea.md", "---\ntitle: Idea\nslug: idea\npublished: true\n---\nReady\n")
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := &scriptedClient{data: map[string]string{
+		"PublishDraft": `{"publishDraft":{"post":{"id":"post-9","slug":"idea"}}}`,
+		"UpdatePost":   `{"updatePost":{"post":{"id":"post-9","slug":"idea"}}}`,
+	}}
+	env := &provider.Env{Client: client, Sum: sum, Stage: st, Yes: true, Logf: t.Logf}
+	plan, err := provider.BuildPlan(env)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := provider.Apply(env, plan); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	if got := strings.Join(client.ops, ","); got != "PublishDraft,UpdatePost" {
+		t.Errorf("requests = %s, want the draft published then updated", got)
+	}
+	if got := sum.Articles["drafts/idea.md"]; got.PostID != "post-9" || got.DraftID != "draft-1" || len(got.History) != 1 {
+		t.Errorf("ledger entry = %+v", got)
+	}
+}
+
+func TestScheduledDraftIsNotCreatedOnceItsDatePasses(t *testing.T) {
+	dir := setupProject(t)
+	scheduled := "---\ntitle: Soon\nslug: soon\npublished_at: 2024-03-07T10:00:00Z\n---\nLater\n"
+	sum := &state.Sum{Blog: state.BlogEntry{PublicationID: "pub-1"}}
+	sum.SetDraft("drafts/soon.md", "draft-2", "imported", "soon")
+	stage(t, dir, "drafts/soon.md", scheduled)
+	st, err := state.LoadStage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := provider.BuildPlan(&provider.Env{Sum: sum, Stage: st})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan) != 1 || plan[0].Type != diff.ActionSkip || !strings.Contains(plan[0].Reason, "hn import") {
+		t.Errorf("expected the scheduled draft skipped until imported, got %+v", plan)
+	}
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"adil-adysh/hashnode-cli/internal/api"
+	"adil-adysh/hashnode-cli/internal/diff"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// redirects manages publication redirection rules declared in redirects.yml.
+// Rules are diffed against the remote rules, so planning needs the API.
+type redirects struct{}
+
+func (redirects) Kind() state.ItemType { return state.TypeRedirect }
+
+func (redirects) Diff(env *Env) ([]diff.PlanItem, error) {
+	desired, staged, err := diff.StagedRedirects(env.Stage)
+	if err != nil || !staged {
+		return nil, err
+	}
+	if desired == nil {
+		return diff.PlanRedirects(nil, nil), nil
+	}
+	remote, err := FetchRedirects(env)
+	if err != nil {
+		return nil, err
+	}
+	return diff.PlanRedirects(desired, remote), nil
+}
+
+// FetchRedirects lists the publication's redirection rules. Rules created by
+// `apply --redirect-slugs` are recorded in hashnode.sum and excluded, so
+// redirects.yml never plans their deletion.
+func FetchRedirects(env *Env) ([]diff.RemoteRedirect, error) {
+	client, err := env.client()
+	if err != nil {
+		return nil, err
+	}
+	s := env.Sum
+	if s == nil || s.Blog.PublicationID == "" {
+		return nil, fmt.Errorf("publication id missing in ledger; run 'hashnode init'")
+	}
+	resp, err := api.GetRedirectionRules(env.ctx(), client, s.Blog.PublicationID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch redirection rules: %w", err)
+	}
+	if resp == nil || resp.Publication == nil {
+		return nil, fmt.Errorf("publication %s not found", s.Blog.PublicationID)
+	}
+
+	owned := make(map[string]bool)
+	for _, a := range s.Articles {
+		for _, r := range a.Redirects {
+			owned[r.RuleID] = true
+		}
+	}
+	var out []diff.RemoteRedirect
+	for _, r := range resp.Publication.RedirectionRules {
+		if owned[r.Id] {
+			continue
+		}
+		out = append(out, diff.RemoteRedirect{
+			ID:          r.Id,
+			Source:      r.Source,
+			Destination: r.Destination,
+			Type:        strings.ToLower(string(r.Type)),
+		})
+	}
+	return out, nil
+}
+
+// Dependencies orders a rule after the article its destination points to,
+// so a redirect never goes live before its target is published.
+func (redirects) Dependencies(env *Env, it diff.PlanItem) []Ref {
+	if it.Redirect == nil || it.Type == diff.ActionDelete {
+		return nil
+	}
+	u, err := url.Parse(it.Redirect.Destination)
+	if err != nil {
+		return nil
+	}
+	if u.IsAbs() {
+		if env.Sum == nil {
+			return nil
+		}
+		if base, err := url.Parse(env.Sum.Blog.PublicationSlug); err != nil || !strings.EqualFold(base.Host, u.Host) {
+			return nil // Points off the publication
+		}
+	}
+	if path, ok := env.articleBySlug(strings.Trim(u.Path, "/")); ok {
+		return []Ref{{Kind: state.TypeArticle, Key: path}}
+	}
+	return nil
+}
+
+func (redirects) Create(env *Env, it diff.PlanItem) error {
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	r := it.Redirect
+	input := api.CreateRedirectionRuleInput{
+		PublicationId: env.Sum.Blog.PublicationID,
+		Source:        r.Source,
+		Destination:   r.Destination,
+		Type:          redirectionType(r.Type),
+	}
+	if err := env.remote(func() error {
+		_, err := api.CreateRedirectionRule(env.ctx(), client, input)
+		return err
+	}); err != nil {
+		return fmt.Errorf("redirect create failed for %s: %w", r.Source, err)
+	}
+	env.logf("Redirect create: %s\n", it.Reason)
+	return nil
+}
+
+func (redirects) Update(env *Env, it diff.PlanItem) error {
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	r := it.Redirect
+	typ := redirectionType(r.Type)
+	input := api.UpdateRedirectionRuleInput{
+		Id:            it.RemoteID,
+		PublicationId: env.Sum.Blog.PublicationID,
+		Source:        &r.Source,
+		Destination:   &r.Destination,
+		Type:          &typ,
+	}
+	if err := env.remote(func() error {
+		_, err := api.UpdateRedirectionRule(env.ctx(), client, input)
+		return err
+	}); err != nil {
+		return fmt.Errorf("redirect update failed for %s: %w", r.Source, err)
+	}
+	env.logf("Redirect update: %s\n", it.Reason)
+	return nil
+}
+
+func (redirects) Delete(env *Env, it diff.PlanItem) error {
+	if err := env.confirmDelete(it); err != nil {
+		return err
+	}
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	input := api.RemoveRedirectionRuleInput{Id: it.RemoteID, PublicationId: env.Sum.Blog.PublicationID}
+	if err := env.remote(func() error {
+		_, err := api.RemoveRedirectionRule(env.ctx(), client, input)
+		return err
+	}); err != nil {
+		return fmt.Errorf("redirect delete failed for %s: %w", it.Title, err)
+	}
+	env.logf("Redirect delete: %s\n", it.Reason)
+	return nil
+}
+
+func redirectionType(t string) api.HttpRedirectionType {
+	if t == state.RedirectTemporary {
+		return api.HttpRedirectionTypeTemporary
+	}
+	return api.HttpRedirectionTypePermanent
+}
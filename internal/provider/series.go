@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+
+	"adil-adysh/hashnode-cli/internal/api"
+	"adil-adysh/hashnode-cli/internal/diff"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// series manages series declared with `hn series create`. They have no file;
+// the stage key is the series slug and the ledger holds the definition.
+type series struct{}
+
+func (series) Kind() state.ItemType { return state.TypeSeries }
+
+func (series) Diff(env *Env) ([]diff.PlanItem, error) {
+	var plan []diff.PlanItem
+	for key, item := range env.Stage.Items {
+		if item.Type != state.TypeSeries {
+			continue
+		}
+		var entry state.SeriesEntry
+		if env.Sum != nil {
+			entry = env.Sum.Series[key]
+		}
+		it := diff.PlanItem{Kind: state.TypeSeries, Path: key, Title: entry.Name, RemoteID: entry.SeriesID}
+		switch {
+		case item.Operation == state.OpDelete:
+			it.Type, it.Reason = diff.ActionSkip, "Series deletion is not supported; remove it in the dashboard"
+		case entry.Name == "":
+			it.Type, it.Reason = diff.ActionSkip, "Series not declared in hashnode.sum"
+		case entry.SeriesID == "":
+			it.Type, it.Reason = diff.ActionCreate, "New series"
+		default:
+			it.Type, it.Reason = diff.ActionSkip, "Up to date"
+		}
+		plan = append(plan, it)
+	}
+	sort.Slice(plan, func(i, j int) bool { return plan[i].Path < plan[j].Path })
+	return plan, nil
+}
+
+func (series) Dependencies(env *Env, it diff.PlanItem) []Ref { return nil }
+
+func (series) Create(env *Env, it diff.PlanItem) error {
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	entry := env.Sum.Series[it.Path]
+	input := api.CreateSeriesInput{Name: entry.Name, Slug: entry.Slug, PublicationId: env.Sum.Blog.PublicationID}
+	if entry.Description != "" {
+		input.DescriptionMarkdown = &entry.Description
+	}
+	var resp *api.CreateSeriesResponse
+	err = env.remote(func() (err error) {
+		resp, err = api.CreateSeries(env.ctx(), client, input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("create series %s failed: %w", entry.Name, err)
+	}
+	if resp == nil || resp.CreateSeries.Series.Id == "" {
+		return fmt.Errorf("create series %s returned no id", entry.Name)
+	}
+	entry.SeriesID = resp.CreateSeries.Series.Id
+	env.Sum.Series[it.Path] = entry
+	env.logf("Created series %s -> %s\n", entry.Name, entry.SeriesID)
+	return nil
+}
+
+func (series) Update(env *Env, it diff.PlanItem) error { return ErrUnsupported }
+
+func (series) Delete(env *Env, it diff.PlanItem) error { return ErrUnsupported }
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"adil-adysh/hashnode-cli/internal/api"
+	"adil-adysh/hashnode-cli/internal/diff"
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// webhooks manages webhooks declared in webhooks.yml. The API cannot list
+// webhooks, so they are diffed against Sum.Webhooks and the ledger is saved
+// after every change: a lost webhook ID could not be recovered.
+type webhooks struct{}
+
+func (webhooks) Kind() state.ItemType { return state.TypeWebhook }
+
+func (webhooks) Diff(env *Env) ([]diff.PlanItem, error) {
+	desired, staged, err := diff.StagedWebhooks(env.Stage)
+	if err != nil || !staged {
+		return nil, err
+	}
+	var applied map[string]state.WebhookSum
+	if env.Sum != nil {
+		applied = env.Sum.Webhooks
+	}
+	return diff.PlanWebhooks(desired, applied), nil
+}
+
+func (webhooks) Dependencies(env *Env, it diff.PlanItem) []Ref { return nil }
+
+func (webhooks) Create(env *Env, it diff.PlanItem) error {
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	w := it.Webhook
+	secret, err := w.Secret()
+	if err != nil {
+		return err
+	}
+	input := api.CreateWebhookInput{
+		PublicationId: env.Sum.Blog.PublicationID,
+		Url:           w.URL,
+		Events:        webhookEvents(w.Events),
+		Secret:        secret,
+	}
+	var resp *api.CreateWebhookResponse
+	err = env.remote(func() (err error) {
+		resp, err = api.CreateWebhook(env.ctx(), client, input)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("webhook create failed for %s: %w", w.Name, err)
+	}
+	if resp == nil || resp.CreateWebhook.Webhook == nil || resp.CreateWebhook.Webhook.Id == "" {
+		return fmt.Errorf("webhook create returned no id for %s", w.Name)
+	}
+	return recordWebhook(env, w.Name, &state.WebhookSum{WebhookID: resp.CreateWebhook.Webhook.Id, Checksum: state.WebhookChecksum(*w)}, it)
+}
+
+func (webhooks) Update(env *Env, it diff.PlanItem) error {
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	w := it.Webhook
+	secret, err := w.Secret()
+	if err != nil {
+		return err
+	}
+	input := api.UpdateWebhookInput{
+		Id:     it.RemoteID,
+		Url:    &w.URL,
+		Events: webhookEvents(w.Events),
+		Secret: &secret,
+	}
+	if err := env.remote(func() error {
+		_, err := api.UpdateWebhook(env.ctx(), client, input)
+		return err
+	}); err != nil {
+		return fmt.Errorf("webhook update failed for %s: %w", w.Name, err)
+	}
+	return recordWebhook(env, w.Name, &state.WebhookSum{WebhookID: it.RemoteID, Checksum: state.WebhookChecksum(*w)}, it)
+}
+
+func (webhooks) Delete(env *Env, it diff.PlanItem) error {
+	if err := env.confirmDelete(it); err != nil {
+		return err
+	}
+	client, err := env.client()
+	if err != nil {
+		return err
+	}
+	if err := env.remote(func() error {
+		_, err := api.DeleteWebhook(env.ctx(), client, it.RemoteID)
+		return err
+	}); err != nil {
+		return fmt.Errorf("webhook delete failed for %s: %w", it.Title, err)
+	}
+	return recordWebhook(env, it.Title, nil, it)
+}
+
+// recordWebhook stores (or, with a nil entry, removes) a webhook in the
+// ledger and saves it immediately.
+func recordWebhook(env *Env, name string, entry *state.WebhookSum, it diff.PlanItem) error {
+	if env.Sum.Webhooks == nil {
+		env.Sum.Webhooks = make(map[string]state.WebhookSum)
+	}
+	if entry == nil {
+		delete(env.Sum.Webhooks, name)
+	} else {
+		env.Sum.Webhooks[name] = *entry
+	}
+	if err := state.SaveSum(env.Sum); err != nil {
+		return fmt.Errorf("failed to save hashnode.sum: %w", err)
+	}
+	env.logf("Webhook %s: %s\n", strings.ToLower(string(it.Type)), it.Reason)
+	return nil
+}
+
+func webhookEvents(events []string) []api.WebhookEvent {
+	out := make([]api.WebhookEvent, len(events))
+	for i, e := range events {
+		out[i] = api.WebhookEvent(e)
+	}
+	return out
+}
@@ -8,6 +8,7 @@ const (
 	LockFile      = "hashnode.lock"
 	ArticlesFile  = "article.yml"
 	SeriesFile    = "series.yml"
+	StashDir      = "stash" // Named stashes of the stage, one file each
 )
 
 // File and directory permissions used across the project
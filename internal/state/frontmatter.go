@@ -1,14 +1,12 @@
 package state
 
 import (
-	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"time"
-
-	"gopkg.in/yaml.v3"
 )
 
 // Frontmatter captures supported YAML fields for posts.
@@ -39,6 +37,8 @@ type Frontmatter struct {
 	Scheduled                 *bool      `yaml:"scheduled"`
 	SlugOverridden            *bool      `yaml:"slug_overridden"`
 	PinToBlog                 *bool      `yaml:"pin_to_blog"`
+	Published                 *bool      `yaml:"published"`
+	HashnodeID                string     `yaml:"hashnode_id"` // Remote post ID; identifies the post independent of its path
 }
 
 // ParseTitleFromFrontmatter extracts the `title` field from YAML frontmatter
@@ -54,54 +54,97 @@ func ParseTitleFromFrontmatter(content []byte) (string, error) {
 	return strings.TrimSpace(fm.Title), nil
 }
 
-// StripFrontmatter removes YAML frontmatter from markdown content and returns the body.
-// If no frontmatter is present, it returns the original content. Invalid frontmatter
-// yields an error so callers can prevent posting malformed payloads.
-// ExtractFrontmatter returns the parsed frontmatter (if present) and the markdown body without frontmatter.
-// When no frontmatter exists, fm is nil and body is the original content.
+// SplitFrontmatter separates the raw frontmatter block of any dialect from
+// the markdown body; see FrontmatterReader.Split.
+func SplitFrontmatter(content []byte) (raw []byte, body []byte, line int, found bool, err error) {
+	return FrontmatterReader{}.Split(content)
+}
+
+// Split separates the raw frontmatter block from the markdown body. found
+// reports whether a frontmatter block was present. line is the 1-based line in
+// content where the raw block starts, so callers can map node positions back to
+// file positions. The body is always a suffix of content.
+func (r FrontmatterReader) Split(content []byte) (raw []byte, body []byte, line int, found bool, err error) {
+	_, raw, body, line, found, err = r.detect(content)
+	return raw, body, line, found, err
+}
+
+// ExtractFrontmatter parses frontmatter of any dialect; see
+// FrontmatterReader.Extract.
 func ExtractFrontmatter(content []byte) (*Frontmatter, []byte, error) {
-	s := bytes.TrimLeft(content, " \t\r\n")
-	if !bytes.HasPrefix(s, []byte("---")) {
+	return FrontmatterReader{}.Extract(content)
+}
+
+// Extract returns the parsed frontmatter (if present) and the markdown body without frontmatter.
+// When no frontmatter exists, fm is nil and body is the original content. Invalid
+// frontmatter yields an error so callers can prevent posting malformed payloads.
+// Aliases are accepted and unknown keys are ignored; use ExtractStrict to
+// report them.
+func (r FrontmatterReader) Extract(content []byte) (*Frontmatter, []byte, error) {
+	res, err := r.ExtractStrict(content)
+	if err != nil {
+		return nil, content, err
+	}
+	if res.Frontmatter == nil {
 		return nil, content, nil
 	}
-
-	// Skip opening delimiter
-	s = s[len("---"):]
-	if bytes.HasPrefix(s, []byte("\r\n")) {
-		s = s[2:]
-	} else if bytes.HasPrefix(s, []byte("\n")) {
-		s = s[1:]
+	for _, issue := range res.Issues {
+		if !issue.Unknown {
+			return nil, content, fmt.Errorf("invalid frontmatter: %s", issue)
+		}
 	}
+	return res.Frontmatter, res.Body, nil
+}
 
-	endDelim := []byte("\n---")
-	idx := bytes.Index(s, endDelim)
-	consumed := len(endDelim)
-	if idx < 0 {
-		endDelim = []byte("\r\n---")
-		idx = bytes.Index(s, endDelim)
-		consumed = len(endDelim)
-		if idx < 0 {
-			return nil, content, fmt.Errorf("frontmatter end delimiter not found")
+// FrontmatterKeys returns the set of YAML keys understood by Frontmatter.
+func FrontmatterKeys() map[string]bool {
+	keys := make(map[string]bool)
+	t := reflect.TypeOf(Frontmatter{})
+	for i := 0; i < t.NumField(); i++ {
+		tag := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+		if tag != "" && tag != "-" {
+			keys[tag] = true
 		}
 	}
+	return keys
+}
 
-	fmBytes := s[:idx]
-	var fm Frontmatter
-	if err := yaml.Unmarshal(fmBytes, &fm); err != nil {
-		return nil, content, fmt.Errorf("invalid frontmatter: %w", err)
-	}
+// identityKeys are frontmatter fields that identify one post and so cannot
+// have repo-wide defaults.
+var identityKeys = map[string]bool{"title": true, "slug": true, "hashnode_id": true}
 
-	body := s[idx+consumed:]
-	// Trim a single leading newline after the closing delimiter
-	if bytes.HasPrefix(body, []byte("\r\n")) {
-		body = body[2:]
-	} else if bytes.HasPrefix(body, []byte("\n")) {
-		body = body[1:]
+// ValidateFrontmatterDefaults rejects defaults that set per-post identity fields.
+func ValidateFrontmatterDefaults(d *Frontmatter) error {
+	if d == nil {
+		return nil
+	}
+	v := reflect.ValueOf(d).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		key := strings.Split(v.Type().Field(i).Tag.Get("yaml"), ",")[0]
+		if identityKeys[key] && !v.Field(i).IsZero() {
+			return fmt.Errorf("%s cannot have a default", key)
+		}
 	}
-	// Drop any remaining leading blank lines
-	body = bytes.TrimLeft(body, "\r\n")
+	return nil
+}
 
-	return &fm, body, nil
+// WithDefaults returns a copy of fm with every unset field taken from d.
+// A nil fm (no frontmatter) yields a copy of d; a nil d returns fm as is.
+func (fm *Frontmatter) WithDefaults(d *Frontmatter) *Frontmatter {
+	if d == nil {
+		return fm
+	}
+	out := &Frontmatter{}
+	if fm != nil {
+		*out = *fm
+	}
+	ov, dv := reflect.ValueOf(out).Elem(), reflect.ValueOf(d).Elem()
+	for i := 0; i < ov.NumField(); i++ {
+		if ov.Field(i).IsZero() && !dv.Field(i).IsZero() {
+			ov.Field(i).Set(dv.Field(i))
+		}
+	}
+	return out
 }
 
 // StripFrontmatter removes YAML frontmatter and returns the body (compat helper).
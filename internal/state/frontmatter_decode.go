@@ -0,0 +1,175 @@
+package state
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FrontmatterAliases maps field names used by other tools (Hugo, Jekyll,
+// dev.to, ...) onto the canonical Frontmatter keys. Every adapter accepts
+// these in addition to its own names.
+var FrontmatterAliases = map[string]string{
+	"cover":             "cover_image_url",
+	"cover_image":       "cover_image_url",
+	"coverImage":        "cover_image_url",
+	"canonical_url":     "canonical",
+	"canonicalUrl":      "canonical",
+	"canonicalURL":      "canonical",
+	"date":              "published_at",
+	"publishedAt":       "published_at",
+	"description":       "meta_description",
+	"banner":            "banner_image_url",
+	"disableComments":   "disable_comments",
+	"coAuthors":         "co_authors",
+	"publishAs":         "publish_as",
+	"enableToc":         "toc",
+	"table_of_contents": "toc",
+}
+
+// FrontmatterIssue describes a key that strict decoding rejected.
+type FrontmatterIssue struct {
+	Line    int    // 1-based line in the markdown file
+	Key     string // Key as written in the file
+	Unknown bool   // True for unknown keys, false for values of the wrong type
+	Message string
+}
+
+func (i FrontmatterIssue) String() string {
+	return fmt.Sprintf("line %d: %s", i.Line, i.Message)
+}
+
+// StrictFrontmatter is the result of ExtractFrontmatterStrict.
+type StrictFrontmatter struct {
+	Frontmatter *Frontmatter // nil when the document has no frontmatter
+	Body        []byte
+	Line        int            // Line where the frontmatter block starts
+	KeyLines    map[string]int // Canonical key -> line it was set on
+	Issues      []FrontmatterIssue
+}
+
+// ExtractFrontmatterStrict decodes frontmatter of any dialect with
+// known-fields checking; see FrontmatterReader.ExtractStrict.
+func ExtractFrontmatterStrict(content []byte) (*StrictFrontmatter, error) {
+	return FrontmatterReader{}.ExtractStrict(content)
+}
+
+// ExtractStrict decodes frontmatter with known-fields checking.
+// Unknown keys and values of the wrong type are reported as issues (with
+// file line numbers) instead of being dropped; valid fields are still decoded.
+// Field names are mapped through the adapter that matched the document (see
+// FrontmatterAdapter). An error is returned only when the frontmatter block
+// itself cannot be parsed or is not in the reader's dialect.
+func (r FrontmatterReader) ExtractStrict(content []byte) (*StrictFrontmatter, error) {
+	adapter, raw, body, line, found, err := r.detect(content)
+	if err != nil {
+		return nil, err
+	}
+	res := &StrictFrontmatter{Body: body, Line: line, KeyLines: make(map[string]int)}
+	if !found {
+		return res, nil
+	}
+
+	doc, err := adapter.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid frontmatter: %w", err)
+	}
+	fm, issues, lines, err := decodeFrontmatterNode(doc, line, adapter.Aliases(), adapter.Converters())
+	if err != nil {
+		return nil, err
+	}
+	res.Frontmatter = fm
+	res.Issues = issues
+	res.KeyLines = lines
+	return res, nil
+}
+
+// decodeFrontmatterNode decodes a YAML document node field by field so each
+// problem can be attributed to a line. firstLine is the file line of the
+// document's first line.
+func decodeFrontmatterNode(doc *yaml.Node, firstLine int, aliases map[string]string, converters map[string]FrontmatterConverter) (*Frontmatter, []FrontmatterIssue, map[string]int, error) {
+	fm := &Frontmatter{}
+	lines := make(map[string]int)
+	var issues []FrontmatterIssue
+
+	root := doc
+	if root.Kind == yaml.DocumentNode {
+		if len(root.Content) == 0 {
+			return fm, nil, lines, nil
+		}
+		root = root.Content[0]
+	}
+	if root.Kind == 0 || (root.Kind == yaml.ScalarNode && root.Tag == "!!null") {
+		return fm, nil, lines, nil
+	}
+	if root.Kind != yaml.MappingNode {
+		return nil, nil, nil, fmt.Errorf("invalid frontmatter: line %d: expected key/value pairs", firstLine+root.Line-1)
+	}
+
+	known := FrontmatterKeys()
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		k, v := root.Content[i], root.Content[i+1]
+		line := firstLine + k.Line - 1
+		convert, converted := converters[k.Value]
+		key := k.Value
+		if converted && convert.Key != "" {
+			key = convert.Key
+		} else if canon, ok := aliases[key]; ok {
+			key = canon
+		}
+		if !converted && !known[key] {
+			issues = append(issues, FrontmatterIssue{Line: line, Key: k.Value, Unknown: true, Message: fmt.Sprintf("unknown field %q", k.Value)})
+			continue
+		}
+		if prev, dup := lines[key]; dup && (!converted || convert.Key != "") {
+			issues = append(issues, FrontmatterIssue{Line: line, Key: k.Value, Message: fmt.Sprintf("field %q duplicates %q set on line %d", k.Value, key, prev)})
+			continue
+		}
+		lines[key] = line
+
+		if converted {
+			if err := convert.Decode(fm, v); err != nil {
+				issues = append(issues, FrontmatterIssue{Line: firstLine + v.Line - 1, Key: k.Value, Message: fmt.Sprintf("field %q: %v", k.Value, err)})
+			}
+			continue
+		}
+
+		// Decode the single pair so type errors are attributed to this key only.
+		pair := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: []*yaml.Node{{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, v}}
+		if err := pair.Decode(fm); err != nil {
+			issues = append(issues, FrontmatterIssue{
+				Line:    firstLine + v.Line - 1,
+				Key:     k.Value,
+				Message: fmt.Sprintf("field %q: expected %s", k.Value, frontmatterFieldKind(key)),
+			})
+		}
+	}
+	return fm, issues, lines, nil
+}
+
+// frontmatterFieldKind describes the Go type behind a canonical key for error messages.
+func frontmatterFieldKind(key string) string {
+	t := reflect.TypeOf(Frontmatter{})
+	for i := 0; i < t.NumField(); i++ {
+		if strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0] != key {
+			continue
+		}
+		ft := t.Field(i).Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		switch {
+		case ft.Kind() == reflect.Bool:
+			return "true or false"
+		case ft.Kind() == reflect.Slice:
+			return "a list of strings"
+		case ft.Name() == "Time":
+			return "a date (YYYY-MM-DD or RFC 3339)"
+		default:
+			return "a string"
+		}
+	}
+	return "a value"
+}
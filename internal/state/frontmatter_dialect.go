@@ -0,0 +1,410 @@
+package state
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// DialectAuto detects the frontmatter syntax from the opening delimiter.
+const DialectAuto = "auto"
+
+// FrontmatterConverter decodes a field whose value needs reshaping rather
+// than a plain rename (e.g. Hugo's `draft: true` becomes `published: false`).
+type FrontmatterConverter struct {
+	// Key is the canonical field the converter sets, so a document that also
+	// sets that field is reported as a duplicate. Converters that merge into
+	// a field (tags from `categories`) leave it empty.
+	Key    string
+	Decode func(fm *Frontmatter, value *yaml.Node) error
+}
+
+// FrontmatterAdapter understands one frontmatter syntax and the field names
+// used by the static-site generators that write it.
+type FrontmatterAdapter interface {
+	// Name identifies the adapter in repository config (e.g. "yaml").
+	Name() string
+	// Split separates the raw frontmatter block from the body. found is false
+	// when content does not start with this adapter's delimiters. line is the
+	// 1-based file line where the raw block starts. body must be a suffix of content.
+	Split(content []byte) (raw []byte, body []byte, line int, found bool, err error)
+	// Parse converts the raw block into a YAML node tree. Node lines are
+	// relative to the start of raw.
+	Parse(raw []byte) (*yaml.Node, error)
+	// Aliases maps the adapter's field names onto canonical Frontmatter keys.
+	Aliases() map[string]string
+	// Converters handles fields that need more than a rename.
+	Converters() map[string]FrontmatterConverter
+}
+
+var frontmatterAdapters = []FrontmatterAdapter{yamlAdapter{}, tomlAdapter{}, jsonAdapter{}}
+
+// RegisterFrontmatterAdapter adds (or replaces by name) a frontmatter adapter.
+func RegisterFrontmatterAdapter(a FrontmatterAdapter) {
+	for i, existing := range frontmatterAdapters {
+		if existing.Name() == a.Name() {
+			frontmatterAdapters[i] = a
+			return
+		}
+	}
+	frontmatterAdapters = append(frontmatterAdapters, a)
+}
+
+// FrontmatterDialects lists the names accepted by NewFrontmatterReader.
+func FrontmatterDialects() []string {
+	names := []string{DialectAuto}
+	for _, a := range frontmatterAdapters {
+		names = append(names, a.Name())
+	}
+	return names
+}
+
+// FrontmatterReader reads (and renders) frontmatter in one dialect. The zero
+// value detects the syntax of each document from its opening delimiter.
+type FrontmatterReader struct {
+	adapter FrontmatterAdapter // nil detects per document
+}
+
+// NewFrontmatterReader returns a reader for the named dialect. DialectAuto
+// (or an empty name) detects the syntax per document.
+func NewFrontmatterReader(dialect string) (FrontmatterReader, error) {
+	if dialect == "" || dialect == DialectAuto {
+		return FrontmatterReader{}, nil
+	}
+	for _, a := range frontmatterAdapters {
+		if a.Name() == dialect {
+			return FrontmatterReader{adapter: a}, nil
+		}
+	}
+	return FrontmatterReader{}, fmt.Errorf("unknown frontmatter dialect %q (expected one of %s)", dialect, strings.Join(FrontmatterDialects(), ", "))
+}
+
+// Dialect returns the name of the reader's dialect, or DialectAuto.
+func (r FrontmatterReader) Dialect() string {
+	if r.adapter == nil {
+		return DialectAuto
+	}
+	return r.adapter.Name()
+}
+
+// detect returns the adapter handling content along with its split. When
+// detecting, the first adapter whose delimiters match wins; a reader pinned
+// to one dialect rejects frontmatter written in another.
+func (r FrontmatterReader) detect(content []byte) (a FrontmatterAdapter, raw []byte, body []byte, line int, found bool, err error) {
+	if r.adapter != nil {
+		raw, body, line, found, err = r.adapter.Split(content)
+		if found || err != nil {
+			return r.adapter, raw, body, line, found, err
+		}
+	}
+	for _, a := range frontmatterAdapters {
+		if r.adapter != nil && a.Name() == r.adapter.Name() {
+			continue
+		}
+		raw, body, line, found, err = a.Split(content)
+		if !found && err == nil {
+			continue
+		}
+		if r.adapter != nil {
+			return nil, nil, content, line, false, fmt.Errorf("line %d: frontmatter is written as %s but the repository uses %s frontmatter", line, a.Name(), r.adapter.Name())
+		}
+		return a, raw, body, line, found, err
+	}
+	return nil, nil, content, 0, false, nil
+}
+
+func mergeAliases(extra map[string]string) map[string]string {
+	out := make(map[string]string, len(FrontmatterAliases)+len(extra))
+	for k, v := range FrontmatterAliases {
+		out[k] = v
+	}
+	for k, v := range extra {
+		out[k] = v
+	}
+	return out
+}
+
+// --- Converters shared by the built-in adapters ---
+
+// convertDraft maps `draft: true` onto `published: false`.
+func convertDraft(fm *Frontmatter, v *yaml.Node) error {
+	var draft bool
+	if err := v.Decode(&draft); err != nil {
+		return err
+	}
+	published := !draft
+	fm.Published = &published
+	return nil
+}
+
+// convertTags accepts a list or a comma-separated string (dev.to style).
+func convertTags(fm *Frontmatter, v *yaml.Node) error {
+	var tags []string
+	if v.Kind == yaml.ScalarNode {
+		for _, t := range strings.Split(v.Value, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				tags = append(tags, t)
+			}
+		}
+	} else if err := v.Decode(&tags); err != nil {
+		return err
+	}
+	fm.Tags = appendUnique(fm.Tags, tags...)
+	return nil
+}
+
+// convertImages uses the first entry of a Hugo `images` list as cover image.
+func convertImages(fm *Frontmatter, v *yaml.Node) error {
+	var images []string
+	if err := v.Decode(&images); err != nil {
+		return err
+	}
+	if len(images) > 0 && fm.CoverImageURL == "" {
+		fm.CoverImageURL = images[0]
+	}
+	return nil
+}
+
+func appendUnique(list []string, items ...string) []string {
+	for _, it := range items {
+		dup := false
+		for _, existing := range list {
+			if strings.EqualFold(existing, it) {
+				dup = true
+				break
+			}
+		}
+		if !dup {
+			list = append(list, it)
+		}
+	}
+	return list
+}
+
+// --- YAML (Hashnode, Jekyll, Astro, dev.to) ---
+
+type yamlAdapter struct{}
+
+func (yamlAdapter) Name() string { return "yaml" }
+
+func (yamlAdapter) Split(content []byte) ([]byte, []byte, int, bool, error) {
+	return splitDelimited(content, "---")
+}
+
+func (yamlAdapter) Parse(raw []byte) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+func (yamlAdapter) Aliases() map[string]string {
+	return mergeAliases(map[string]string{
+		"pubDate":   "published_at", // Astro
+		"heroImage": "cover_image_url",
+		"image":     "cover_image_url", // Jekyll themes
+	})
+}
+
+func (yamlAdapter) Converters() map[string]FrontmatterConverter {
+	return map[string]FrontmatterConverter{
+		"draft":      {Key: "published", Decode: convertDraft},
+		"tags":       {Decode: convertTags},
+		"categories": {Decode: convertTags},
+	}
+}
+
+// --- TOML (Hugo) ---
+
+type tomlAdapter struct{}
+
+var tomlKeyRe = regexp.MustCompile(`^\s*([A-Za-z0-9_-]+|"[^"]+")\s*=`)
+
+func (tomlAdapter) Name() string { return "toml" }
+
+func (tomlAdapter) Split(content []byte) ([]byte, []byte, int, bool, error) {
+	return splitDelimited(content, "+++")
+}
+
+// Parse decodes TOML and rebuilds it as a YAML mapping whose key and value
+// lines point at the original TOML lines.
+func (tomlAdapter) Parse(raw []byte) (*yaml.Node, error) {
+	var m map[string]interface{}
+	if err := toml.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+
+	// Record the line of each top-level key (keys before the first [table]).
+	lines := make(map[string]int)
+	for i, l := range strings.Split(string(raw), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(l), "[") {
+			break
+		}
+		if match := tomlKeyRe.FindStringSubmatch(l); match != nil {
+			lines[strings.Trim(match[1], `"`)] = i + 1
+		}
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return lines[keys[i]] < lines[keys[j]] || (lines[keys[i]] == lines[keys[j]] && keys[i] < keys[j])
+	})
+
+	mapping := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Line: 1}
+	for _, k := range keys {
+		var v yaml.Node
+		if err := v.Encode(tomlToYAMLValue(m[k])); err != nil {
+			return nil, err
+		}
+		line := lines[k]
+		if line == 0 {
+			line = 1
+		}
+		v.Line = line
+		mapping.Content = append(mapping.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: k, Line: line}, &v)
+	}
+	return &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{mapping}, Line: 1}, nil
+}
+
+func (tomlAdapter) Aliases() map[string]string { return hugoAliases() }
+
+func (tomlAdapter) Converters() map[string]FrontmatterConverter { return hugoConverters() }
+
+// tomlToYAMLValue converts TOML local date/time values into time.Time so
+// they decode into Frontmatter.PublishedAt.
+func tomlToYAMLValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case toml.LocalDate:
+		return t.AsTime(time.UTC)
+	case toml.LocalDateTime:
+		return t.AsTime(time.UTC)
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i := range t {
+			out[i] = tomlToYAMLValue(t[i])
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			out[k] = tomlToYAMLValue(val)
+		}
+		return out
+	}
+	return v
+}
+
+// --- JSON (Hugo) ---
+
+type jsonAdapter struct{}
+
+func (jsonAdapter) Name() string { return "json" }
+
+// Split finds a JSON object at the start of the document; the object must be
+// followed by a newline or the end of the file.
+func (jsonAdapter) Split(content []byte) ([]byte, []byte, int, bool, error) {
+	s := bytes.TrimLeft(content, " \t\r\n")
+	if !bytes.HasPrefix(s, []byte("{")) {
+		return nil, content, 0, false, nil
+	}
+	// Only an object literal counts; this keeps Hugo shortcodes such as
+	// `{{< figure >}}` at the top of a body from being read as frontmatter.
+	if rest := bytes.TrimLeft(s[1:], " \t\r\n"); len(rest) == 0 || (rest[0] != '"' && rest[0] != '}') {
+		return nil, content, 0, false, nil
+	}
+	line := 1 + bytes.Count(content[:len(content)-len(s)], []byte("\n"))
+
+	dec := json.NewDecoder(bytes.NewReader(s))
+	var obj json.RawMessage
+	if err := dec.Decode(&obj); err != nil {
+		return nil, content, line, true, fmt.Errorf("invalid JSON frontmatter: %w", err)
+	}
+	end := int(dec.InputOffset())
+	raw := s[:end]
+	body := bytes.TrimLeft(s[end:], " \t")
+	body = bytes.TrimLeft(body, "\r\n")
+	return raw, body, line, true, nil
+}
+
+// Parse relies on JSON being valid YAML so node positions are preserved.
+func (jsonAdapter) Parse(raw []byte) (*yaml.Node, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+func (jsonAdapter) Aliases() map[string]string { return hugoAliases() }
+
+func (jsonAdapter) Converters() map[string]FrontmatterConverter { return hugoConverters() }
+
+func hugoAliases() map[string]string {
+	return mergeAliases(map[string]string{
+		"summary": "subtitle",
+	})
+}
+
+func hugoConverters() map[string]FrontmatterConverter {
+	return map[string]FrontmatterConverter{
+		"draft":      {Key: "published", Decode: convertDraft},
+		"tags":       {Decode: convertTags},
+		"categories": {Decode: convertTags},
+		"images":     {Key: "cover_image_url", Decode: convertImages},
+	}
+}
+
+// splitDelimited splits frontmatter fenced by delim lines (`---` or `+++`).
+func splitDelimited(content []byte, delim string) (raw []byte, body []byte, line int, found bool, err error) {
+	s := bytes.TrimLeft(content, " \t\r\n")
+	if !bytes.HasPrefix(s, []byte(delim)) {
+		return nil, content, 0, false, nil
+	}
+	line = 1 + bytes.Count(content[:len(content)-len(s)], []byte("\n"))
+
+	// Skip opening delimiter
+	s = s[len(delim):]
+	if bytes.HasPrefix(s, []byte("\r\n")) {
+		s = s[2:]
+		line++
+	} else if bytes.HasPrefix(s, []byte("\n")) {
+		s = s[1:]
+		line++
+	}
+
+	endDelim := []byte("\n" + delim)
+	idx := bytes.Index(s, endDelim)
+	consumed := len(endDelim)
+	if idx < 0 {
+		endDelim = []byte("\r\n" + delim)
+		idx = bytes.Index(s, endDelim)
+		consumed = len(endDelim)
+		if idx < 0 {
+			return nil, content, line, true, fmt.Errorf("frontmatter end delimiter not found")
+		}
+	}
+
+	raw = s[:idx]
+	body = s[idx+consumed:]
+	// Trim a single leading newline after the closing delimiter
+	if bytes.HasPrefix(body, []byte("\r\n")) {
+		body = body[2:]
+	} else if bytes.HasPrefix(body, []byte("\n")) {
+		body = body[1:]
+	}
+	// Drop any remaining leading blank lines
+	body = bytes.TrimLeft(body, "\r\n")
+
+	return raw, body, line, true, nil
+}
@@ -0,0 +1,401 @@
+package state
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FrontmatterEditor changes individual keys of a document's YAML frontmatter.
+// Edits are spliced into the original text at the positions yaml.Node reports,
+// so comments, key order, quoting style, line endings and the markdown body are
+// left exactly as written outside the value being changed.
+type FrontmatterEditor struct {
+	content []byte
+}
+
+// NewFrontmatterEditor returns an editor over a copy of content.
+func NewFrontmatterEditor(content []byte) *FrontmatterEditor {
+	return &FrontmatterEditor{content: append([]byte(nil), content...)}
+}
+
+// Bytes returns the edited document.
+func (e *FrontmatterEditor) Bytes() []byte {
+	return e.content
+}
+
+// Set updates key to value, or appends it to the end of the frontmatter when
+// absent. A document without frontmatter gets a new block. If the file spells
+// the key with an alias (e.g. `cover` for cover_image_url) the alias is edited
+// in place. Strings keep the quoting style of the value they replace and lists
+// keep their flow or block layout; new lists are written in flow style.
+func (e *FrontmatterEditor) Set(key string, value interface{}) error {
+	var n yaml.Node
+	if err := n.Encode(value); err != nil {
+		return fmt.Errorf("frontmatter %s: %w", key, err)
+	}
+	if n.Kind == yaml.SequenceNode {
+		n.Style = yaml.FlowStyle
+	}
+
+	blk, err := e.parse()
+	if err != nil {
+		return err
+	}
+	if blk == nil {
+		entry, err := renderFrontmatterEntry(key, &n, "\n")
+		if err != nil {
+			return err
+		}
+		e.content = append([]byte("---\n"+entry+"\n---\n\n"), e.content...)
+		return nil
+	}
+
+	i := blk.find(key)
+	if i < 0 {
+		entry, err := renderFrontmatterEntry(key, &n, blk.nl)
+		if err != nil {
+			return err
+		}
+		if blk.end > blk.start {
+			entry = blk.nl + entry
+		}
+		e.splice(blk.end, blk.end, entry)
+		return nil
+	}
+
+	k, old := blk.root.Content[i], blk.root.Content[i+1]
+	if old.Kind == yaml.ScalarNode && n.Kind == yaml.ScalarNode && n.Tag == "!!str" &&
+		(old.Style == yaml.DoubleQuotedStyle || old.Style == yaml.SingleQuotedStyle) {
+		n.Style = old.Style
+	}
+	if old.Kind == yaml.SequenceNode && n.Kind == yaml.SequenceNode {
+		n.Style = old.Style & yaml.FlowStyle
+	}
+
+	entry, err := renderFrontmatterEntry(k.Value, &n, blk.nl)
+	if err != nil {
+		return err
+	}
+	first, last := blk.entryLines(i)
+	keyLine := blk.line(first)
+	colon := frontmatterColon(keyLine, k)
+	if colon < 0 {
+		return fmt.Errorf("frontmatter %s: cannot locate key on line %d", key, k.Line)
+	}
+
+	// Single-line value replaced by a single-line value: swap only the value
+	// token so spacing and trailing comments survive.
+	if first == last && !strings.Contains(entry, "\n") {
+		value := strings.TrimSpace(entry[strings.Index(entry, ":")+1:])
+		start := colon + 1
+		end := start
+		if old.Line == k.Line && !(old.Tag == "!!null" && old.Value == "") {
+			start = runeOffset(keyLine, old.Column-1)
+			end = scanYAMLToken(keyLine, start)
+		} else {
+			value = " " + value
+		}
+		base := blk.lines[first-1]
+		e.splice(base+start, base+end, value)
+		return nil
+	}
+
+	// Otherwise rewrite the entry's lines, keeping the key as written and any
+	// comment that followed it on the key line.
+	comment := ""
+	if old.Line != k.Line {
+		comment = strings.TrimSpace(keyLine[colon+1:])
+	} else if c := strings.TrimSpace(keyLine[scanYAMLToken(keyLine, runeOffset(keyLine, old.Column-1)):]); strings.HasPrefix(c, "#") {
+		comment = c
+	}
+	lines := strings.Split(entry, blk.nl)
+	head := keyLine[:colon+1] + lines[0][strings.Index(lines[0], ":")+1:]
+	if comment != "" {
+		head += " " + comment
+	}
+	lines[0] = head
+	if old.Kind == yaml.SequenceNode && old.Style&yaml.FlowStyle == 0 && len(old.Content) > 0 &&
+		old.Content[0].Column-2 == k.Column {
+		// The file writes block lists without indentation ("- a" under the key).
+		for j := 1; j < len(lines); j++ {
+			lines[j] = strings.TrimPrefix(lines[j], "  ")
+		}
+	}
+	e.splice(blk.lines[first-1], blk.lineEnd(last), strings.Join(lines, blk.nl))
+	return nil
+}
+
+// Delete removes key (or its alias) and its value from the frontmatter.
+// Comments above the key are kept. Deleting a missing key is a no-op.
+func (e *FrontmatterEditor) Delete(key string) error {
+	blk, err := e.parse()
+	if err != nil || blk == nil {
+		return err
+	}
+	i := blk.find(key)
+	if i < 0 {
+		return nil
+	}
+	first, last := blk.entryLines(i)
+	start, end := blk.lines[first-1], blk.lineEnd(last)
+	// Take the line break with the entry: the following one when there is a
+	// next line, otherwise the preceding one.
+	if end < blk.end {
+		end += len(blk.nl)
+	} else if start > blk.start {
+		start -= len(blk.nl)
+	}
+	e.splice(start, end, "")
+	return nil
+}
+
+// EditFrontmatterFile applies edit to the file at path and writes the result
+// back atomically, keeping the file mode. changed reports whether the content
+// differs from what was on disk.
+func EditFrontmatterFile(path string, edit func(*FrontmatterEditor) error) (changed bool, err error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+	ed := NewFrontmatterEditor(content)
+	if err := edit(ed); err != nil {
+		return false, fmt.Errorf("%s: %w", path, err)
+	}
+	if bytes.Equal(content, ed.Bytes()) {
+		return false, nil
+	}
+	if err := AtomicWriteFile(path, ed.Bytes(), info.Mode().Perm()); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (e *FrontmatterEditor) splice(start, end int, text string) {
+	out := make([]byte, 0, len(e.content)-(end-start)+len(text))
+	out = append(out, e.content[:start]...)
+	out = append(out, text...)
+	out = append(out, e.content[end:]...)
+	e.content = out
+}
+
+// fmBlock locates the raw YAML frontmatter inside the editor's content.
+type fmBlock struct {
+	content    []byte
+	start, end int        // Byte range of the raw block (between the delimiters)
+	lines      []int      // Byte offset of each raw line; node line n is lines[n-1]
+	root       *yaml.Node // Top-level mapping, nil when the block is empty
+	nl         string     // Line ending used by the block
+}
+
+// parse returns nil (and no error) when the document has no frontmatter.
+// Frontmatter in another dialect cannot be edited and yields an error.
+func (e *FrontmatterEditor) parse() (*fmBlock, error) {
+	raw, _, _, found, err := yamlAdapter{}.Split(e.content)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		for _, a := range frontmatterAdapters {
+			if _, ok := a.(yamlAdapter); ok {
+				continue
+			}
+			if _, _, _, ok, _ := a.Split(e.content); ok {
+				return nil, fmt.Errorf("cannot edit %s frontmatter; only YAML is supported", a.Name())
+			}
+		}
+		return nil, nil
+	}
+
+	// raw is a sub-slice of content, so its offset falls out of the capacities.
+	start := cap(e.content) - cap(raw)
+	blk := &fmBlock{content: e.content, start: start, end: start + len(raw), nl: "\n"}
+	if bytes.Contains(raw, []byte("\r\n")) {
+		blk.nl = "\r\n"
+	}
+	blk.lines = append(blk.lines, start)
+	for i, c := range raw {
+		if c == '\n' {
+			blk.lines = append(blk.lines, start+i+1)
+		}
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return nil, fmt.Errorf("invalid frontmatter: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return blk, nil
+	}
+	root := doc.Content[0]
+	if root.Kind == yaml.ScalarNode && root.Tag == "!!null" {
+		return blk, nil
+	}
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("invalid frontmatter: expected key/value pairs")
+	}
+	blk.root = root
+	return blk, nil
+}
+
+// find returns the index in root.Content of key, matching aliases too, or -1.
+func (b *fmBlock) find(key string) int {
+	if b.root == nil {
+		return -1
+	}
+	aliases := yamlAdapter{}.Aliases()
+	alias := -1
+	for i := 0; i+1 < len(b.root.Content); i += 2 {
+		k := b.root.Content[i].Value
+		if k == key {
+			return i
+		}
+		if alias < 0 && aliases[k] == key {
+			alias = i
+		}
+	}
+	return alias
+}
+
+// line returns the text of raw line n (1-based) without its line ending.
+func (b *fmBlock) line(n int) string {
+	return strings.TrimSuffix(string(b.content[b.lines[n-1]:b.lineEnd(n)]), "\r")
+}
+
+// lineEnd returns the offset just before the line break ending raw line n.
+func (b *fmBlock) lineEnd(n int) int {
+	if n < len(b.lines) {
+		end := b.lines[n] - 1
+		if end > b.start && b.content[end-1] == '\r' {
+			end--
+		}
+		return end
+	}
+	return b.end
+}
+
+// entryLines returns the first and last raw lines of the pair at index i.
+// Trailing blank lines and top-level comments belong to what follows.
+func (b *fmBlock) entryLines(i int) (int, int) {
+	k := b.root.Content[i]
+	last := len(b.lines)
+	if i+2 < len(b.root.Content) {
+		last = b.root.Content[i+2].Line - 1
+	}
+	for last > k.Line {
+		text := b.line(last)
+		trimmed := strings.TrimSpace(text)
+		indent := len(text) - len(strings.TrimLeft(text, " \t"))
+		if trimmed != "" && !(strings.HasPrefix(trimmed, "#") && indent < k.Column) {
+			break
+		}
+		last--
+	}
+	return k.Line, last
+}
+
+// renderFrontmatterEntry encodes `key: value` using the line ending nl.
+func renderFrontmatterEntry(key string, value *yaml.Node, nl string) (string, error) {
+	entry := &yaml.Node{Kind: yaml.MappingNode, Content: []*yaml.Node{
+		{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+		value,
+	}}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(entry); err != nil {
+		return "", fmt.Errorf("frontmatter %s: %w", key, err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+	out := strings.TrimSuffix(buf.String(), "\n")
+	return strings.ReplaceAll(out, "\n", nl), nil
+}
+
+// frontmatterColon returns the byte offset of the ':' ending key k on line.
+func frontmatterColon(line string, k *yaml.Node) int {
+	start := runeOffset(line, k.Column-1)
+	if start >= len(line) {
+		return -1
+	}
+	from := start
+	if k.Style == yaml.DoubleQuotedStyle || k.Style == yaml.SingleQuotedStyle {
+		from = scanYAMLToken(line, start)
+	}
+	idx := strings.Index(line[from:], ":")
+	if idx < 0 {
+		return -1
+	}
+	return from + idx
+}
+
+// scanYAMLToken returns the offset just past the single-line value starting at
+// start: a quoted string, a flow collection, or a plain scalar up to a comment.
+func scanYAMLToken(line string, start int) int {
+	if start >= len(line) {
+		return len(line)
+	}
+	switch line[start] {
+	case '"':
+		for i := start + 1; i < len(line); i++ {
+			switch line[i] {
+			case '\\':
+				i++
+			case '"':
+				return i + 1
+			}
+		}
+		return len(line)
+	case '\'':
+		for i := start + 1; i < len(line); i++ {
+			if line[i] == '\'' {
+				if i+1 < len(line) && line[i+1] == '\'' {
+					i++
+					continue
+				}
+				return i + 1
+			}
+		}
+		return len(line)
+	case '[', '{':
+		depth := 0
+		for i := start; i < len(line); i++ {
+			switch c := line[i]; c {
+			case '"', '\'':
+				i = scanYAMLToken(line, i) - 1
+			case '[', '{':
+				depth++
+			case ']', '}':
+				depth--
+				if depth == 0 {
+					return i + 1
+				}
+			}
+		}
+		return len(line)
+	}
+	end := len(line)
+	if idx := strings.Index(line[start:], " #"); idx >= 0 {
+		end = start + idx
+	}
+	return start + len(strings.TrimRight(line[start:end], " \t"))
+}
+
+// runeOffset converts a rune column (as reported by yaml.v3) to a byte offset.
+func runeOffset(s string, col int) int {
+	off := 0
+	for i := 0; i < col && off < len(s); i++ {
+		_, size := utf8.DecodeRuneInString(s[off:])
+		off += size
+	}
+	return off
+}
@@ -0,0 +1,127 @@
+package state
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func editFrontmatter(t *testing.T, input string, edit func(*FrontmatterEditor) error) string {
+	t.Helper()
+	ed := NewFrontmatterEditor([]byte(input))
+	if err := edit(ed); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return string(ed.Bytes())
+}
+
+func TestFrontmatterEditorUpdatePreservesLayout(t *testing.T) {
+	input := "---\n# Post settings\ntitle: \"Old Title\"   # shown on the card\nslug: old-slug\ntags:\n- go\n- cli\n---\n\n# Body\n\n  kept   as is\n"
+	got := editFrontmatter(t, input, func(ed *FrontmatterEditor) error {
+		if err := ed.Set("title", "New Title"); err != nil {
+			return err
+		}
+		if err := ed.Set("slug", "new-slug"); err != nil {
+			return err
+		}
+		return ed.Set("tags", []string{"go", "yaml"})
+	})
+	want := "---\n# Post settings\ntitle: \"New Title\"   # shown on the card\nslug: new-slug\ntags:\n- go\n- yaml\n---\n\n# Body\n\n  kept   as is\n"
+	if got != want {
+		t.Fatalf("unexpected result:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFrontmatterEditorInsertAppendsKey(t *testing.T) {
+	input := "---\ntitle: 'Hello World'\ntags: [a, b]\n---\nBody"
+	got := editFrontmatter(t, input, func(ed *FrontmatterEditor) error {
+		if err := ed.Set("hashnode_id", "65a1b2c3d4e5f6a7b8c9d0e1"); err != nil {
+			return err
+		}
+		return ed.Set("tags", []string{"a", "b", "c"})
+	})
+	want := "---\ntitle: 'Hello World'\ntags: [a, b, c]\nhashnode_id: 65a1b2c3d4e5f6a7b8c9d0e1\n---\nBody"
+	if got != want {
+		t.Fatalf("unexpected result:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestFrontmatterEditorAliasAndTypes(t *testing.T) {
+	input := "---\r\ntitle: Hello World\r\ncover: old.png\r\npublished: true\r\nslug:\r\n---\r\nBody\r\n"
+	got := editFrontmatter(t, input, func(ed *FrontmatterEditor) error {
+		if err := ed.Set("cover_image_url", "https://example.com/c.png"); err != nil {
+			return err
+		}
+		if err := ed.Set("published", false); err != nil {
+			return err
+		}
+		return ed.Set("slug", "hello-world")
+	})
+	want := "---\r\ntitle: Hello World\r\ncover: https://example.com/c.png\r\npublished: false\r\nslug: hello-world\r\n---\r\nBody\r\n"
+	if got != want {
+		t.Fatalf("unexpected result:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestFrontmatterEditorQuotesWhenNeeded(t *testing.T) {
+	got := editFrontmatter(t, "---\ntitle: Plain\n---\n", func(ed *FrontmatterEditor) error {
+		return ed.Set("title", "Go: a tour # of sorts")
+	})
+	fm, _, err := ExtractFrontmatter([]byte(got))
+	if err != nil {
+		t.Fatalf("edited frontmatter does not parse: %v\n%s", err, got)
+	}
+	if fm.Title != "Go: a tour # of sorts" {
+		t.Fatalf("title round-trip failed: %q", fm.Title)
+	}
+}
+
+func TestFrontmatterEditorDelete(t *testing.T) {
+	input := "---\ntitle: Hello World\n# the tags\ntags:\n  - a\n  - b\n\nslug: x\n---\nBody"
+	got := editFrontmatter(t, input, func(ed *FrontmatterEditor) error {
+		if err := ed.Delete("tags"); err != nil {
+			return err
+		}
+		return ed.Delete("slug")
+	})
+	want := "---\ntitle: Hello World\n# the tags\n\n---\nBody"
+	if got != want {
+		t.Fatalf("unexpected result:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestFrontmatterEditorCreatesBlock(t *testing.T) {
+	got := editFrontmatter(t, "# Body\n", func(ed *FrontmatterEditor) error {
+		return ed.Set("title", "Hello World")
+	})
+	if got != "---\ntitle: Hello World\n---\n\n# Body\n" {
+		t.Fatalf("unexpected result: %q", got)
+	}
+}
+
+func TestFrontmatterEditorRejectsTOML(t *testing.T) {
+	ed := NewFrontmatterEditor([]byte("+++\ntitle = \"Hello\"\n+++\nBody"))
+	if err := ed.Set("slug", "hello"); err == nil || !strings.Contains(err.Error(), "toml") {
+		t.Fatalf("expected unsupported dialect error, got %v", err)
+	}
+}
+
+func TestEditFrontmatterFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "post.md")
+	if err := os.WriteFile(path, []byte("---\ntitle: Hello World\n---\nBody\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	changed, err := EditFrontmatterFile(path, func(ed *FrontmatterEditor) error { return ed.Set("title", "Hello World") })
+	if err != nil || changed {
+		t.Fatalf("expected no change, got changed=%v err=%v", changed, err)
+	}
+	changed, err = EditFrontmatterFile(path, func(ed *FrontmatterEditor) error { return ed.Set("slug", "hello") })
+	if err != nil || !changed {
+		t.Fatalf("expected change, got changed=%v err=%v", changed, err)
+	}
+	data, _ := os.ReadFile(path)
+	if string(data) != "---\ntitle: Hello World\nslug: hello\n---\nBody\n" {
+		t.Fatalf("unexpected file content: %q", data)
+	}
+}
@@ -0,0 +1,65 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// FrontmatterField is one key of a frontmatter block to render.
+type FrontmatterField struct {
+	Key   string
+	Value interface{}
+}
+
+// RenderArticle builds a new markdown document from frontmatter fields and a
+// body, writing the block in the reader's dialect (YAML when detecting) so it
+// reads back. YAML keeps the field order; TOML and JSON sort keys.
+func (r FrontmatterReader) RenderArticle(fields []FrontmatterField, body string) ([]byte, error) {
+	var b strings.Builder
+	switch r.Dialect() {
+	case "toml":
+		raw, err := toml.Marshal(fieldMap(fields))
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&b, "+++\n%s+++\n", raw)
+	case "json":
+		raw, err := json.MarshalIndent(fieldMap(fields), "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&b, "%s\n", raw)
+	default:
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		for _, f := range fields {
+			var v yaml.Node
+			if err := v.Encode(f.Value); err != nil {
+				return nil, fmt.Errorf("frontmatter %s: %w", f.Key, err)
+			}
+			if v.Kind == yaml.SequenceNode {
+				v.Style = yaml.FlowStyle
+			}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: f.Key}, &v)
+		}
+		raw, err := yaml.Marshal(node)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&b, "---\n%s---\n", raw)
+	}
+	b.WriteString("\n")
+	b.WriteString(body)
+	return []byte(b.String()), nil
+}
+
+func fieldMap(fields []FrontmatterField) map[string]interface{} {
+	m := make(map[string]interface{}, len(fields))
+	for _, f := range fields {
+		m[f.Key] = f.Value
+	}
+	return m
+}
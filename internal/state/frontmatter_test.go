@@ -1,6 +1,9 @@
 package state
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestStripFrontmatterRemovesBlock(t *testing.T) {
 	input := []byte("---\ntitle: Hello\nslug: hello\n---\n\n# Heading\nBody text\n")
@@ -31,3 +34,165 @@ func TestStripFrontmatterInvalid(t *testing.T) {
 		t.Fatalf("expected error for invalid frontmatter")
 	}
 }
+
+func TestExtractFrontmatterAliases(t *testing.T) {
+	input := []byte("---\ntitle: Hello World\ncover: https://example.com/c.png\ncanonical_url: https://example.com/a\ndate: 2024-03-01\npublished: false\n---\nBody")
+	fm, _, err := ExtractFrontmatter(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fm.CoverImageURL != "https://example.com/c.png" || fm.Canonical != "https://example.com/a" {
+		t.Fatalf("aliases not applied: %+v", fm)
+	}
+	if fm.PublishedAt == nil || fm.PublishedAt.Year() != 2024 {
+		t.Fatalf("expected date alias to set published_at, got %v", fm.PublishedAt)
+	}
+	if fm.Published == nil || *fm.Published {
+		t.Fatalf("expected published=false, got %v", fm.Published)
+	}
+}
+
+func TestExtractFrontmatterStrictReportsIssues(t *testing.T) {
+	input := []byte("---\ntitle: Hello World\ncanonial: https://example.com\npublished_at: someday\ntoc: maybe\n---\nBody")
+	res, err := ExtractFrontmatterStrict(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Frontmatter.Title != "Hello World" {
+		t.Fatalf("valid fields should still decode, got %+v", res.Frontmatter)
+	}
+	want := map[string]struct {
+		line    int
+		unknown bool
+	}{
+		"canonial":     {3, true},
+		"published_at": {4, false},
+		"toc":          {5, false},
+	}
+	if len(res.Issues) != len(want) {
+		t.Fatalf("expected %d issues, got %v", len(want), res.Issues)
+	}
+	for _, issue := range res.Issues {
+		w, ok := want[issue.Key]
+		if !ok {
+			t.Fatalf("unexpected issue %v", issue)
+		}
+		if issue.Line != w.line || issue.Unknown != w.unknown {
+			t.Errorf("%s: got line %d unknown=%v, want line %d unknown=%v", issue.Key, issue.Line, issue.Unknown, w.line, w.unknown)
+		}
+	}
+
+	// Lenient extraction ignores unknown keys but rejects mistyped values.
+	if _, _, err := ExtractFrontmatter(input); err == nil {
+		t.Fatalf("expected error for mistyped values")
+	}
+	if _, _, err := ExtractFrontmatter([]byte("---\ntitle: Hello World\ncanonial: x\n---\n")); err != nil {
+		t.Fatalf("unknown keys should be ignored, got %v", err)
+	}
+}
+
+func TestExtractFrontmatterTOML(t *testing.T) {
+	input := []byte("+++\ntitle = \"Hugo Post Title\"\ndate = 2024-05-06\ndraft = true\ncategories = [\"go\"]\ntags = [\"cli\"]\nunknownkey = 1\n+++\n\nBody\n")
+	res, err := ExtractFrontmatterStrict(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fm := res.Frontmatter
+	if fm.Title != "Hugo Post Title" || string(res.Body) != "Body\n" {
+		t.Fatalf("unexpected decode: %+v body=%q", fm, res.Body)
+	}
+	if fm.PublishedAt == nil || fm.PublishedAt.Month() != 5 {
+		t.Fatalf("expected date mapped to published_at, got %v", fm.PublishedAt)
+	}
+	if fm.Published == nil || *fm.Published {
+		t.Fatalf("expected draft=true to map to published=false")
+	}
+	if len(fm.Tags) != 2 {
+		t.Fatalf("expected categories and tags merged, got %v", fm.Tags)
+	}
+	if len(res.Issues) != 1 || res.Issues[0].Line != 7 {
+		t.Fatalf("expected unknown key issue on line 7, got %v", res.Issues)
+	}
+}
+
+func TestExtractFrontmatterJSON(t *testing.T) {
+	input := []byte("{\n  \"title\": \"JSON Post Title\",\n  \"canonical_url\": \"https://example.com\"\n}\nBody\n")
+	fm, body, err := ExtractFrontmatter(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fm.Title != "JSON Post Title" || fm.Canonical != "https://example.com" || string(body) != "Body\n" {
+		t.Fatalf("unexpected decode: %+v body=%q", fm, body)
+	}
+
+	// A Hugo shortcode at the top of the body is not frontmatter.
+	plain := []byte("{{< figure src=\"a.png\" >}}\nBody\n")
+	if fm, _, err := ExtractFrontmatter(plain); err != nil || fm != nil {
+		t.Fatalf("expected no frontmatter, got %+v, %v", fm, err)
+	}
+}
+
+func TestFrontmatterReaderDialect(t *testing.T) {
+	if _, err := NewFrontmatterReader("nope"); err == nil {
+		t.Fatalf("expected error for unknown dialect")
+	}
+	r, err := NewFrontmatterReader("toml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// YAML frontmatter is rejected when the repo is pinned to TOML.
+	if _, _, err := r.Extract([]byte("---\ntitle: YAML Post\n---\nBody")); err == nil || !strings.Contains(err.Error(), "toml") {
+		t.Fatalf("expected an error naming the toml dialect, got %v", err)
+	}
+	if fm, _, err := r.Extract([]byte("Just a body")); err != nil || fm != nil {
+		t.Fatalf("expected no frontmatter, got %+v, %v", fm, err)
+	}
+
+	doc, err := r.RenderArticle([]FrontmatterField{{Key: "title", Value: "TOML Post"}}, "Body\n")
+	if err != nil || !strings.HasPrefix(string(doc), "+++\n") {
+		t.Fatalf("expected a TOML document, got %q, %v", doc, err)
+	}
+	if fm, _, err := r.Extract(doc); err != nil || fm.Title != "TOML Post" {
+		t.Fatalf("rendered document does not read back: %+v, %v", fm, err)
+	}
+	// Other readers are unaffected.
+	if fm, _, err := ExtractFrontmatter([]byte("---\ntitle: YAML Post\n---\nBody")); err != nil || fm.Title != "YAML Post" {
+		t.Fatalf("expected detection to read YAML, got %+v, %v", fm, err)
+	}
+}
+
+func TestConvertedKeysAreCheckedForDuplicates(t *testing.T) {
+	res, err := ExtractFrontmatterStrict([]byte("---\ntitle: Post\npublished: true\ndraft: true\n---\nBody"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Issues) != 1 || res.Issues[0].Line != 4 || !strings.Contains(res.Issues[0].Message, `"published"`) {
+		t.Fatalf("expected draft reported as duplicating published on line 4, got %v", res.Issues)
+	}
+	if res.Frontmatter.Published == nil || !*res.Frontmatter.Published {
+		t.Errorf("the first key must win, got %v", res.Frontmatter.Published)
+	}
+	if _, _, err := ExtractFrontmatter([]byte("---\ndraft: true\npublished: true\n---\n")); err == nil {
+		t.Error("expected ExtractFrontmatter to reject the duplicate")
+	}
+}
+
+func TestFrontmatterWithDefaults(t *testing.T) {
+	on, off := true, false
+	defaults := &Frontmatter{Tags: []string{"go"}, EnableToc: &on, Series: "Go Tips"}
+	fm := &Frontmatter{Title: "Post", Tags: []string{"rust"}, EnableToc: &off}
+
+	got := fm.WithDefaults(defaults)
+	if len(got.Tags) != 1 || got.Tags[0] != "rust" || *got.EnableToc || got.Series != "Go Tips" {
+		t.Errorf("set fields must win and unset ones take the default, got %+v", got)
+	}
+	if fm.Series != "" {
+		t.Error("WithDefaults must not modify the receiver")
+	}
+	if got := (*Frontmatter)(nil).WithDefaults(defaults); got == nil || got.Series != "Go Tips" {
+		t.Errorf("nil frontmatter should yield the defaults, got %+v", got)
+	}
+	if err := ValidateFrontmatterDefaults(&Frontmatter{Slug: "x"}); err == nil {
+		t.Error("expected a slug default to be rejected")
+	}
+}
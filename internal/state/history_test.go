@@ -0,0 +1,77 @@
+package state_test
+
+import (
+	"fmt"
+	"testing"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+func TestGCKeepsRecentHistory(t *testing.T) {
+	setupTrackedProject(t)
+	store := state.NewSnapshotStore()
+	sum := mustLoadSum(t)
+
+	total := state.HistoryRetention + 2
+	var checksums []string
+	for i := 0; i < total; i++ {
+		snap, err := store.Create([]byte(fmt.Sprintf("---\ntitle: Tracked Post\n---\nVersion %d", i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		checksums = append(checksums, snap.Checksum)
+		sum.SetArticle("post.md", "post-1", snap.Checksum, "tracked-post")
+		sum.RecordPublish("post.md", snap.Checksum, nil)
+	}
+	if err := state.SaveSum(sum); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(mustLoadSum(t).Articles["post.md"].History); got != total {
+		t.Fatalf("expected %d history entries, got %d", total, got)
+	}
+	stats, err := store.GC(false)
+	if err != nil {
+		t.Fatalf("GC failed: %v", err)
+	}
+	if stats.RemovedCount != total-state.HistoryRetention {
+		t.Errorf("expected %d snapshots removed, got %d", total-state.HistoryRetention, stats.RemovedCount)
+	}
+	for i, c := range checksums {
+		kept := store.Exists(c + ".md")
+		if want := i >= total-state.HistoryRetention; kept != want {
+			t.Errorf("version %d: kept=%v, want %v", i+1, kept, want)
+		}
+	}
+}
+
+func TestFindVersion(t *testing.T) {
+	a := state.ArticleSum{History: []state.PublishRecord{
+		{Checksum: "aaaaaa111111"},
+		{Checksum: "bbbbbb222222"},
+		{Checksum: "aaaaaa111111"},
+		{Checksum: "123456abcdef"},
+	}}
+	cases := []struct {
+		ref     string
+		want    int
+		wantErr bool
+	}{
+		{ref: "2", want: 2},
+		{ref: "#2", want: 2},
+		{ref: "5", wantErr: true},
+		{ref: "#123456", wantErr: true},
+		{ref: "123456", want: 4}, // All-digit checksum prefix
+		{ref: "0", wantErr: true},
+		{ref: "bbbbbb", want: 2},
+		{ref: "AAAAAA1", want: 3}, // Latest match wins
+		{ref: "aaa", wantErr: true},
+		{ref: "cccccc", wantErr: true},
+	}
+	for _, c := range cases {
+		got, err := a.FindVersion(c.ref)
+		if (err != nil) != c.wantErr || got != c.want {
+			t.Errorf("FindVersion(%q) = %d, %v; want %d (error %v)", c.ref, got, err, c.want, c.wantErr)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package state
+
+import (
+	"bufio"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// IgnoreFile holds gitignore-style rules for the directory it is in and
+// everything below it.
+const IgnoreFile = ".hnignore"
+
+// IgnoreRule is one pattern from an ignore source.
+type IgnoreRule struct {
+	Source  string // Repo-relative file the rule came from, or a description
+	Line    int    // Line in Source; 0 for rules that are not from a file
+	Pattern string // As written
+	Negate  bool   // "!pattern" re-includes what an earlier rule ignored
+	DirOnly bool   // "pattern/" only matches directories
+
+	base string // Repo-relative directory the pattern is relative to
+	glob string // Pattern without "!", leading and trailing "/"
+	path bool   // Pattern contains a "/" and is matched against the path
+}
+
+// String renders the rule as `hn check-ignore` prints it.
+func (r *IgnoreRule) String() string {
+	if r.Line == 0 {
+		return fmt.Sprintf("%s (%s)", r.Source, r.Pattern)
+	}
+	return fmt.Sprintf("%s:%d (%s)", r.Source, r.Line, r.Pattern)
+}
+
+func (r *IgnoreRule) matches(key string, isDir bool) bool {
+	if r.DirOnly && !isDir {
+		return false
+	}
+	rel := key
+	if r.base != "" {
+		if !strings.HasPrefix(key, r.base+"/") {
+			return false
+		}
+		rel = strings.TrimPrefix(key, r.base+"/")
+	}
+	if !r.path {
+		rel = path.Base(rel)
+	}
+	ok, _ := doublestar.Match(r.glob, rel)
+	return ok
+}
+
+// builtinIgnoreRules apply before any .hnignore: hidden directories such as
+// .git and .hashnode are never content.
+var builtinIgnoreRules = []IgnoreRule{
+	{Source: "built-in", Pattern: ".*/", DirOnly: true, glob: ".*"},
+}
+
+// repoIgnoreRules are extra rules from repo config (see SetRepoIgnore),
+// applied as if they were at the top of the root .hnignore.
+var repoIgnoreRules []IgnoreRule
+
+// SetRepoIgnore sets gitignore-style rules that apply to the whole repo.
+// source names them in `hn check-ignore` output.
+func SetRepoIgnore(lines []string, source string) {
+	repoIgnoreRules = ParseIgnoreRules([]byte(strings.Join(lines, "\n")), "", source)
+}
+
+// contentInclude holds the repo's content include patterns (see
+// SetContentInclude). Empty means every markdown file is content.
+var contentInclude []string
+
+// SetContentInclude restricts content to markdown files matching one of the
+// patterns (doublestar syntax, relative to the repo root). Ignore rules still
+// apply to included files.
+func SetContentInclude(patterns []string) error {
+	for _, p := range patterns {
+		if !doublestar.ValidatePattern(p) {
+			return fmt.Errorf("invalid include pattern %q", p)
+		}
+	}
+	contentInclude = patterns
+	return nil
+}
+
+// ParseIgnoreRules parses gitignore-style lines. base is the repo-relative
+// directory the rules apply to ("" for the repo root) and source names them.
+func ParseIgnoreRules(data []byte, base, source string) []IgnoreRule {
+	var rules []IgnoreRule
+	sc := bufio.NewScanner(strings.NewReader(string(data)))
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimRight(sc.Text(), " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		r := IgnoreRule{Source: source, Line: n, Pattern: line, base: base}
+		if strings.HasPrefix(line, "!") {
+			r.Negate, line = true, line[1:]
+		} else if strings.HasPrefix(line, `\`) {
+			line = line[1:] // Escaped leading "#" or "!"
+		}
+		if strings.HasSuffix(line, "/") {
+			r.DirOnly, line = true, strings.TrimRight(line, "/")
+		}
+		r.path = strings.Contains(line, "/")
+		r.glob = strings.TrimPrefix(line, "/")
+		if r.glob == "" || !doublestar.ValidatePattern(r.glob) {
+			continue
+		}
+		rules = append(rules, r)
+	}
+	return rules
+}
+
+// Ignorer decides which repo files are content. It reads every .hnignore on
+// the way from the repo root to a path, deeper files taking precedence over
+// shallower ones and over repo config, and caches them for its lifetime.
+type Ignorer struct {
+	root    string
+	repo    []IgnoreRule
+	include []string
+	mu      sync.Mutex
+	rules   map[string][]IgnoreRule // By repo-relative directory
+}
+
+// NewIgnorer returns an Ignorer for the current project.
+func NewIgnorer() *Ignorer {
+	return &Ignorer{root: ProjectRootOrCwd(), repo: repoIgnoreRules, include: contentInclude, rules: make(map[string][]IgnoreRule)}
+}
+
+// IgnoreMatch explains the decision for one path. Rule is the rule that
+// decided it, or nil when no rule matched.
+type IgnoreMatch struct {
+	Ignored bool
+	Rule    *IgnoreRule
+}
+
+// Match decides whether the repo-relative key is ignored. A path inside an
+// ignored directory is ignored by that directory's rule, as with git.
+func (ig *Ignorer) Match(key string, isDir bool) IgnoreMatch {
+	key = strings.Trim(path.Clean(filepath.ToSlash(key)), "/")
+	if key == "." || key == "" {
+		return IgnoreMatch{}
+	}
+	parts := strings.Split(key, "/")
+	for i := 1; i < len(parts); i++ {
+		if m := ig.matchOne(strings.Join(parts[:i], "/"), true); m.Ignored {
+			return m
+		}
+	}
+	m := ig.matchOne(key, isDir)
+	if m.Ignored || isDir || len(ig.include) == 0 || !isMarkdownPath(key) {
+		return m
+	}
+	for _, p := range ig.include {
+		if ok, _ := doublestar.Match(p, key); ok {
+			return m
+		}
+	}
+	return IgnoreMatch{Ignored: true, Rule: &IgnoreRule{Source: "hashnode.yml", Pattern: "not matched by content.include"}}
+}
+
+// matchOne applies the built-in and repo rules, then those of every
+// .hnignore above key; the last matching rule wins.
+func (ig *Ignorer) matchOne(key string, isDir bool) IgnoreMatch {
+	var m IgnoreMatch
+	check := func(rules []IgnoreRule) {
+		for i := range rules {
+			if rules[i].matches(key, isDir) {
+				m = IgnoreMatch{Ignored: !rules[i].Negate, Rule: &rules[i]}
+			}
+		}
+	}
+	check(builtinIgnoreRules)
+	check(ig.repo)
+	dir := ""
+	check(ig.dirRules(dir))
+	for _, part := range strings.Split(path.Dir(key), "/") {
+		if part == "." {
+			break
+		}
+		dir = path.Join(dir, part)
+		check(ig.dirRules(dir))
+	}
+	return m
+}
+
+func (ig *Ignorer) dirRules(dir string) []IgnoreRule {
+	ig.mu.Lock()
+	defer ig.mu.Unlock()
+	if rules, ok := ig.rules[dir]; ok {
+		return rules
+	}
+	source := path.Join(dir, IgnoreFile)
+	data, err := os.ReadFile(filepath.Join(ig.root, filepath.FromSlash(source)))
+	var rules []IgnoreRule
+	if err == nil {
+		rules = ParseIgnoreRules(data, dir, source)
+	}
+	ig.rules[dir] = rules
+	return rules
+}
+
+// IsIgnored reports whether the file or directory at p (relative to the
+// working directory or absolute) is ignored.
+func (ig *Ignorer) IsIgnored(p string, isDir bool) bool {
+	return ig.Match(NormalizePath(p), isDir).Ignored
+}
+
+// WalkContent walks dir like filepath.WalkDir and calls fn for every file
+// that is not ignored by .hnignore, repo config or the built-in rules.
+// Ignored directories are not descended into. Every file walker in hn goes
+// through here so that all commands agree on what is content.
+func WalkContent(dir string, fn func(p string, d fs.DirEntry) error) error {
+	ig := NewIgnorer()
+	return filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p != dir && ig.IsIgnored(p, true) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if ig.IsIgnored(p, false) {
+			return nil
+		}
+		return fn(p, d)
+	})
+}
+
+func isMarkdownPath(key string) bool {
+	ext := strings.ToLower(path.Ext(key))
+	return ext == ".md" || ext == ".markdown"
+}
@@ -0,0 +1,146 @@
+package state_test
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+func writeFiles(t *testing.T, files map[string]string) {
+	t.Helper()
+	for p, c := range files {
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(c), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestIgnorerMatch(t *testing.T) {
+	setupTrackedProject(t)
+	writeFiles(t, map[string]string{
+		".hnignore":       "# Not articles\nREADME.md\ntemplates/\n/drafts/\n*.tmp.md\n",
+		"posts/.hnignore": "!README.md\n",
+	})
+	ig := state.NewIgnorer()
+	cases := []struct {
+		key     string
+		isDir   bool
+		ignored bool
+		line    int // Deciding rule line; 0 for none
+	}{
+		{key: "README.md", ignored: true, line: 2},
+		{key: "docs/README.md", ignored: true, line: 2},
+		{key: "posts/README.md", ignored: false, line: 1}, // Re-included by posts/.hnignore
+		{key: "templates/post.md", ignored: true, line: 3},
+		{key: "templates", isDir: true, ignored: true, line: 3},
+		{key: "drafts/idea.md", ignored: true, line: 4},
+		{key: "posts/drafts/idea.md", ignored: false},   // Anchored to the root
+		{key: "posts/x.tmp.md", ignored: true, line: 5}, // Unanchored matches at any depth
+		{key: "posts/hello.md", ignored: false},
+	}
+	for _, c := range cases {
+		m := ig.Match(c.key, c.isDir)
+		line := 0
+		if m.Rule != nil {
+			line = m.Rule.Line
+		}
+		if m.Ignored != c.ignored || line != c.line {
+			t.Errorf("Match(%q) = ignored %v by line %d; want %v by line %d", c.key, m.Ignored, line, c.ignored, c.line)
+		}
+	}
+	if m := ig.Match(".git/config", false); !m.Ignored || m.Rule.Source != "built-in" {
+		t.Errorf("expected hidden directories to be ignored by the built-in rule, got %+v", m)
+	}
+}
+
+func TestWalkContentAppliesIgnoreAndInclude(t *testing.T) {
+	setupTrackedProject(t)
+	writeFiles(t, map[string]string{
+		".hnignore":         "drafts/\n",
+		"posts/a.md":        "a",
+		"posts/drafts/b.md": "b",
+		"notes/c.md":        "c",
+		"pages/about.md":    "about",
+		".github/README.md": "hidden",
+	})
+	walk := func() []string {
+		var got []string
+		err := state.WalkContent(".", func(p string, d fs.DirEntry) error {
+			if filepath.Ext(p) == ".md" {
+				got = append(got, filepath.ToSlash(p))
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("WalkContent failed: %v", err)
+		}
+		sort.Strings(got)
+		return got
+	}
+
+	if got, want := walk(), []string{"notes/c.md", "pages/about.md", "post.md", "posts/a.md"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("WalkContent = %v, want %v", got, want)
+	}
+
+	if err := state.SetContentInclude([]string{"posts/**", "pages/**"}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { state.SetContentInclude(nil) })
+	if got, want := walk(), []string{"pages/about.md", "posts/a.md"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("WalkContent with include = %v, want %v", got, want)
+	}
+}
+
+func TestExpandStagePathsAppliesIgnoreAndInclude(t *testing.T) {
+	setupTrackedProject(t)
+	writeFiles(t, map[string]string{
+		".hnignore":         "drafts/\n",
+		"posts/a.md":        "a",
+		"posts/drafts/d.md": "d",
+		"notes/c.md":        "c",
+	})
+	expand := func(args ...string) []string {
+		t.Helper()
+		paths, err := state.ExpandStagePaths(args)
+		if err != nil {
+			t.Fatalf("ExpandStagePaths failed: %v", err)
+		}
+		sel, err := state.SelectStageFiles(paths, state.StageFilter{})
+		if err != nil {
+			t.Fatalf("SelectStageFiles failed: %v", err)
+		}
+		var got []string
+		for _, p := range sel.Files {
+			got = append(got, state.NormalizePath(p))
+		}
+		sort.Strings(got)
+		return got
+	}
+
+	if got, want := expand("posts/**"), []string{"posts/a.md"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("posts/** staged %v, want %v", got, want)
+	}
+	if _, err := state.ExpandStagePaths([]string{"posts/drafts/*.md"}); err == nil {
+		t.Error("expected an error for a pattern matching only ignored files")
+	}
+	// Naming an ignored file explicitly still stages it
+	if got, want := expand("posts/drafts/d.md"), []string{"posts/drafts/d.md"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("explicit path staged %v, want %v", got, want)
+	}
+
+	if err := state.SetContentInclude([]string{"posts/**"}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { state.SetContentInclude(nil) })
+	if got, want := expand("**/*.md"), []string{"posts/a.md"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("**/*.md with include staged %v, want %v", got, want)
+	}
+}
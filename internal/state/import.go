@@ -0,0 +1,46 @@
+package state
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// ImportFilter selects the remote posts `hn import` writes.
+type ImportFilter struct {
+	Since  time.Time
+	After  *time.Time // Incremental mark: only posts changed after it
+	Slugs  []string
+	Tags   []string // Tag slugs, matched by the API (any of them)
+	Series []string // Series slugs or names
+	IDs    []string
+}
+
+// Selective reports whether the filter may leave out posts other than those
+// already imported by an earlier run.
+func (f ImportFilter) Selective() bool {
+	return !f.Since.IsZero() || len(f.Slugs) > 0 || len(f.Tags) > 0 || len(f.Series) > 0 || len(f.IDs) > 0
+}
+
+// Keep reports whether a listed post passes every filter except tags, which
+// the API has already applied.
+func (f ImportFilter) Keep(id, slug, seriesName, seriesSlug string, changed time.Time) bool {
+	if f.After != nil && !changed.After(*f.After) {
+		return false
+	}
+	if !f.Since.IsZero() && changed.Before(f.Since) {
+		return false
+	}
+	if len(f.Slugs) > 0 && !slices.Contains(f.Slugs, slug) {
+		return false
+	}
+	if len(f.IDs) > 0 && !slices.Contains(f.IDs, id) {
+		return false
+	}
+	if len(f.Series) > 0 {
+		return slices.ContainsFunc(f.Series, func(s string) bool {
+			return seriesSlug != "" && (strings.EqualFold(s, seriesSlug) || strings.EqualFold(s, seriesName))
+		})
+	}
+	return true
+}
@@ -0,0 +1,109 @@
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+func TestAdvanceImportMarkOnlyMovesForward(t *testing.T) {
+	setupTrackedProject(t)
+	sum := mustLoadSum(t)
+	if sum.Import.PostsUpdatedAt != nil {
+		t.Fatalf("new ledger has an import mark: %v", sum.Import.PostsUpdatedAt)
+	}
+
+	mark := time.Date(2024, 3, 7, 10, 0, 0, 0, time.UTC)
+	sum.AdvanceImportMark(mark)
+	sum.AdvanceImportMark(mark.Add(-time.Hour))
+	sum.AdvanceImportMark(time.Time{})
+	if err := state.SaveSum(sum); err != nil {
+		t.Fatal(err)
+	}
+
+	got := mustLoadSum(t).Import.PostsUpdatedAt
+	if got == nil || !got.Equal(mark) {
+		t.Fatalf("expected mark %v, got %v", mark, got)
+	}
+}
+
+func TestMergeSeriesKeepsLocalDescription(t *testing.T) {
+	sum := &state.Sum{Series: map[string]state.SeriesEntry{
+		"go":    {SeriesID: "s1", Name: "Go", Slug: "go", Description: "Local notes"},
+		"local": {Name: "Local only", Slug: "local"},
+	}}
+	sum.MergeSeries(state.SeriesEntry{SeriesID: "s1", Name: "Go Basics", Slug: "go"})
+	sum.MergeSeries(state.SeriesEntry{SeriesID: "s2", Name: "Rust", Slug: "rust", Description: "Remote"})
+
+	if got := sum.Series["go"]; got.Name != "Go Basics" || got.Description != "Local notes" {
+		t.Errorf("go series = %+v", got)
+	}
+	if got := sum.Series["rust"]; got.SeriesID != "s2" || got.Description != "Remote" {
+		t.Errorf("rust series = %+v", got)
+	}
+	if _, ok := sum.Series["local"]; !ok {
+		t.Error("series missing remotely was dropped")
+	}
+}
+
+func TestSetDraftKeepsPublishedPost(t *testing.T) {
+	sum := &state.Sum{}
+	sum.SetDraft("drafts/idea.md", "draft-1", "c1", "idea")
+	if got := sum.Articles["drafts/idea.md"]; got.DraftID != "draft-1" || got.PostID != "" {
+		t.Fatalf("draft entry = %+v", got)
+	}
+
+	sum.SetArticle("drafts/idea.md", "post-1", "c2", "idea")
+	sum.SetDraft("drafts/idea.md", "draft-1", "c3", "idea")
+	if got := sum.Articles["drafts/idea.md"]; got.PostID != "post-1" || got.DraftID != "draft-1" || got.Checksum != "c3" {
+		t.Errorf("entry after publish = %+v", got)
+	}
+}
+
+func TestImportFilterKeep(t *testing.T) {
+	mark := time.Date(2024, 3, 7, 10, 0, 0, 0, time.UTC)
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	type post struct {
+		id, slug, seriesName, seriesSlug string
+		changed                          time.Time
+	}
+	inSeries := post{"p1", "intro", "Go Basics", "go-basics", mark.Add(time.Hour)}
+	old := post{"p2", "old", "", "", since.Add(-time.Hour)}
+
+	cases := []struct {
+		name   string
+		filter state.ImportFilter
+		post   post
+		want   bool
+	}{
+		{"no filter", state.ImportFilter{}, old, true},
+		{"id match", state.ImportFilter{IDs: []string{"p1"}}, inSeries, true},
+		{"id miss", state.ImportFilter{IDs: []string{"p1"}}, old, false},
+		{"slug match", state.ImportFilter{Slugs: []string{"old", "other"}}, old, true},
+		{"slug miss", state.ImportFilter{Slugs: []string{"Intro"}}, inSeries, false},
+		{"series slug", state.ImportFilter{Series: []string{"go-basics"}}, inSeries, true},
+		{"series name ignores case", state.ImportFilter{Series: []string{"go basics"}}, inSeries, true},
+		{"series outside any series", state.ImportFilter{Series: []string{""}}, old, false},
+		{"since keeps later", state.ImportFilter{Since: since}, inSeries, true},
+		{"since drops earlier", state.ImportFilter{Since: since}, old, false},
+		{"since keeps equal", state.ImportFilter{Since: since}, post{changed: since}, true},
+		{"incremental keeps later", state.ImportFilter{After: &mark}, inSeries, true},
+		{"incremental drops the mark itself", state.ImportFilter{After: &mark}, post{changed: mark}, false},
+		{"every filter must pass", state.ImportFilter{IDs: []string{"p1"}, Slugs: []string{"old"}}, inSeries, false},
+	}
+	for _, c := range cases {
+		p := c.post
+		if got := c.filter.Keep(p.id, p.slug, p.seriesName, p.seriesSlug, p.changed); got != c.want {
+			t.Errorf("%s: Keep = %v, want %v", c.name, got, c.want)
+		}
+	}
+
+	if (state.ImportFilter{After: &mark}).Selective() {
+		t.Error("an incremental run alone is not selective")
+	}
+	if !(state.ImportFilter{Tags: []string{"go"}}).Selective() {
+		t.Error("a tag filter is selective")
+	}
+}
@@ -0,0 +1,285 @@
+package state
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MoveResult describes what MovePath changed.
+type MoveResult struct {
+	From, To  string // Normalized paths
+	PostID    string // Remote post that followed the file ("" when untracked)
+	FileMoved bool   // False when the file had already been moved outside hn
+	Restaged  bool   // True when an existing staged item was re-keyed
+}
+
+// MovePath moves a markdown file and re-keys its ledger entry and staged item
+// so the remote post follows the file. If the move already happened outside
+// hn (e.g. `git mv`), only the ledger and stage are updated. When the staged
+// item is missing the new path is staged from disk. Any failure rolls back
+// the file move and the ledger.
+func MovePath(oldPath, newPath string) (*MoveResult, error) {
+	from, to := NormalizePath(oldPath), NormalizePath(newPath)
+	if from == to {
+		return nil, fmt.Errorf("source and destination are the same: %s", from)
+	}
+	oldAbs, newAbs := absRepoPath(from), absRepoPath(to)
+	if !inRepo(newAbs) || isInStateDir(newAbs) {
+		return nil, fmt.Errorf("invalid destination: %s", to)
+	}
+	if info, err := os.Stat(oldAbs); err == nil && info.IsDir() {
+		return nil, fmt.Errorf("%s is a directory; move articles one at a time", from)
+	}
+	// The path decides whether a file is an article or a static page (see
+	// ItemTypeForPath); the ledger cannot carry a post over to a page
+	kind := ItemTypeForPath(from)
+	if kind != TypeArticle && kind != TypePage {
+		return nil, fmt.Errorf("%s is not an article or page", from)
+	}
+	if toKind := ItemTypeForPath(to); toKind != kind {
+		return nil, fmt.Errorf("cannot move %s to %s: it would change from %s to %s; create the new file and hn rm the old one instead",
+			from, to, strings.ToLower(string(kind)), strings.ToLower(string(toKind)))
+	}
+
+	st, err := LoadStage()
+	if err != nil {
+		return nil, err
+	}
+	if item, ok := st.Items[from]; ok && item.Operation == OpDelete {
+		return nil, fmt.Errorf("%s is staged for deletion; unstage it first", from)
+	}
+	sumPath := filepath.Join(ProjectRootOrCwd(), SumFile)
+	sumBackup, sumErr := os.ReadFile(sumPath)
+	if sumErr != nil && !os.IsNotExist(sumErr) {
+		return nil, sumErr
+	}
+
+	res := &MoveResult{From: from, To: to}
+
+	// 1. Working tree
+	_, oldErr := os.Stat(oldAbs)
+	_, newErr := os.Stat(newAbs)
+	switch {
+	case oldErr == nil && newErr == nil:
+		return nil, fmt.Errorf("destination already exists: %s", to)
+	case oldErr == nil:
+		if err := os.MkdirAll(filepath.Dir(newAbs), DirPerm); err != nil {
+			return nil, err
+		}
+		if err := os.Rename(oldAbs, newAbs); err != nil {
+			return nil, fmt.Errorf("failed to move %s: %w", from, err)
+		}
+		res.FileMoved = true
+	case newErr != nil:
+		return nil, fmt.Errorf("neither %s nor %s exists", from, to)
+	}
+	rollback := func(cause error) error {
+		if sumErr == nil {
+			_ = AtomicWriteFile(sumPath, sumBackup, FilePerm)
+		}
+		if res.FileMoved {
+			if err := os.Rename(newAbs, oldAbs); err != nil {
+				return fmt.Errorf("%w (and failed to move %s back: %v)", cause, to, err)
+			}
+		}
+		return cause
+	}
+
+	// 2. Ledger
+	if sumErr == nil {
+		sum, err := LoadSum()
+		if err != nil {
+			return nil, rollback(err)
+		}
+		moved := false
+		if kind == TypePage {
+			if moved = sum.MovePage(from, to); moved {
+				res.PostID = sum.Pages[to].PageID
+			}
+		} else if moved = sum.MoveArticle(from, to); moved {
+			res.PostID = sum.Articles[to].PostID
+		}
+		if moved {
+			if err := SaveSum(sum); err != nil {
+				return nil, rollback(fmt.Errorf("failed to save %s: %w", SumFile, err))
+			}
+		}
+	}
+
+	// 3. Stage: keep staged intent, otherwise stage the moved file
+	if item, ok := st.Items[from]; ok {
+		delete(st.Items, from)
+		item.Key = to
+		st.Items[to] = item
+		res.Restaged = true
+	} else {
+		content, err := os.ReadFile(newAbs)
+		if err != nil {
+			return nil, rollback(err)
+		}
+		snap, err := NewSnapshotStore().Create(content)
+		if err != nil {
+			return nil, rollback(err)
+		}
+		st.Items[to] = StagedItem{
+			Type:      kind,
+			Key:       to,
+			Operation: OpModify,
+			Checksum:  snap.Checksum,
+			Snapshot:  snap.Filename,
+			StagedAt:  time.Now(),
+		}
+	}
+	if err := SaveStage(st); err != nil {
+		return nil, rollback(fmt.Errorf("failed to save stage: %w", err))
+	}
+	return res, nil
+}
+
+// TrackedPaths returns the ledger paths of articles and pages equal to path
+// or below it when path names a directory. The path does not need to exist on
+// disk.
+func TrackedPaths(sum *Sum, path string) []string {
+	if sum == nil {
+		return nil
+	}
+	key := strings.TrimSuffix(NormalizePath(path), "/")
+	var out []string
+	for p := range sum.Articles {
+		if p == key || key == "." || strings.HasPrefix(p, key+"/") {
+			out = append(out, p)
+		}
+	}
+	for p := range sum.Pages {
+		if p == key || key == "." || strings.HasPrefix(p, key+"/") {
+			out = append(out, p)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// RemovePaths stages the deletion of every tracked article or page at or
+// below path and, unless keepFiles is set, removes the files from the working
+// tree.
+// Files that were only staged (never published) are unstaged instead. If a
+// file cannot be removed, the stage is restored for it and the paths after it.
+// Like `git rm`, it refuses to remove a working file holding work found
+// nowhere else (see checkRemovable) unless force is set.
+func RemovePaths(path string, keepFiles, force bool) ([]string, error) {
+	st, err := LoadStage()
+	if err != nil {
+		return nil, err
+	}
+	sum, err := LoadSum()
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	targets := TrackedPaths(sum, path)
+	tracked := make(map[string]bool, len(targets))
+	for _, p := range targets {
+		tracked[p] = true
+	}
+	key := strings.TrimSuffix(NormalizePath(path), "/")
+	for p := range st.Items {
+		if !tracked[p] && (p == key || key == "." || strings.HasPrefix(p, key+"/")) {
+			targets = append(targets, p)
+		}
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("%s does not match any tracked or staged article", key)
+	}
+	sort.Strings(targets)
+	if !keepFiles && !force {
+		if err := checkRemovable(targets, tracked, sum, st); err != nil {
+			return nil, err
+		}
+	}
+
+	backup := make(map[string]StagedItem, len(targets))
+	for _, p := range targets {
+		if item, ok := st.Items[p]; ok {
+			backup[p] = item
+		}
+	}
+	for _, p := range targets {
+		if tracked[p] {
+			st.Items[p] = StagedItem{Type: ItemTypeForPath(p), Key: p, Operation: OpDelete, StagedAt: time.Now()}
+		} else {
+			delete(st.Items, p)
+		}
+	}
+	if err := SaveStage(st); err != nil {
+		return nil, err
+	}
+
+	if !keepFiles {
+		for i, p := range targets {
+			if err := os.Remove(absRepoPath(p)); err != nil && !os.IsNotExist(err) {
+				// Keep the deletions of files already removed; restore the rest
+				for _, q := range targets[i:] {
+					if item, ok := backup[q]; ok {
+						st.Items[q] = item
+					} else {
+						delete(st.Items, q)
+					}
+				}
+				if serr := SaveStage(st); serr != nil {
+					return nil, fmt.Errorf("failed to remove %s: %w (and failed to restore stage: %v)", p, err, serr)
+				}
+				return targets[:i], fmt.Errorf("failed to remove %s: %w", p, err)
+			}
+		}
+	}
+	return targets, nil
+}
+
+// checkRemovable refuses removing working files that were never applied, or
+// whose content matches neither the ledger nor the stage: nothing could
+// restore them afterwards.
+func checkRemovable(targets []string, tracked map[string]bool, sum *Sum, st *Stage) error {
+	var untracked, unapplied []string
+	for _, p := range targets {
+		content, err := os.ReadFile(absRepoPath(p))
+		if err != nil {
+			continue // Already gone
+		}
+		if !tracked[p] {
+			untracked = append(untracked, p)
+			continue
+		}
+		checksum := ChecksumFromContent(content)
+		if ledger, _ := ledgerChecksum(sum, p); checksum == ledger {
+			continue
+		}
+		if item, ok := st.Items[p]; ok && item.Operation != OpDelete && item.Checksum == checksum {
+			continue
+		}
+		unapplied = append(unapplied, p)
+	}
+	var reasons []string
+	if len(untracked) > 0 {
+		reasons = append(reasons, "never applied: "+strings.Join(untracked, ", "))
+	}
+	if len(unapplied) > 0 {
+		reasons = append(reasons, "changes neither applied nor staged: "+strings.Join(unapplied, ", "))
+	}
+	if len(reasons) == 0 {
+		return nil
+	}
+	return fmt.Errorf("refusing to remove files with work found nowhere else (%s); use --cached to keep them or --force to remove them", strings.Join(reasons, "; "))
+}
+
+// absRepoPath resolves a normalized path against the project root.
+func absRepoPath(key string) string {
+	p := filepath.FromSlash(key)
+	if filepath.IsAbs(p) {
+		return p
+	}
+	return filepath.Join(ProjectRootOrCwd(), p)
+}
@@ -0,0 +1,243 @@
+package state_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+// setupTrackedProject creates a project with one published article (post.md).
+func setupTrackedProject(t *testing.T) string {
+	t.Helper()
+	tempDir := t.TempDir()
+	origDir, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(origDir)
+		state.ResetProjectRootCache()
+	})
+	if err := os.Chdir(tempDir); err != nil {
+		t.Fatalf("chdir failed: %v", err)
+	}
+	state.ResetProjectRootCache()
+	if err := os.MkdirAll(filepath.Join(tempDir, ".hashnode"), 0755); err != nil {
+		t.Fatalf("mkdir .hashnode failed: %v", err)
+	}
+
+	content := []byte("---\ntitle: Tracked Post\n---\nBody")
+	if err := os.WriteFile("post.md", content, 0644); err != nil {
+		t.Fatalf("write failed: %v", err)
+	}
+	sum := &state.Sum{Articles: map[string]state.ArticleSum{
+		"post.md": {PostID: "post-1", Checksum: state.ChecksumFromContent(content), Slug: "tracked-post"},
+	}}
+	if err := state.SaveSum(sum); err != nil {
+		t.Fatalf("SaveSum failed: %v", err)
+	}
+	return tempDir
+}
+
+func TestMovePathRekeysLedgerAndStage(t *testing.T) {
+	setupTrackedProject(t)
+	if err := os.WriteFile("post.md", []byte("---\ntitle: Tracked Post\n---\nEdited"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := state.StageAdd("post.md"); err != nil {
+		t.Fatalf("StageAdd failed: %v", err)
+	}
+	before, _ := state.LoadStage()
+	staged := before.Items["post.md"]
+
+	res, err := state.MovePath("post.md", "posts/renamed.md")
+	if err != nil {
+		t.Fatalf("MovePath failed: %v", err)
+	}
+	if !res.FileMoved || !res.Restaged || res.PostID != "post-1" {
+		t.Errorf("unexpected result: %+v", res)
+	}
+	if _, err := os.Stat("posts/renamed.md"); err != nil {
+		t.Errorf("file not moved: %v", err)
+	}
+
+	sum, _ := state.LoadSum()
+	if _, ok := sum.Articles["post.md"]; ok {
+		t.Error("old ledger key still present")
+	}
+	if sum.Articles["posts/renamed.md"].PostID != "post-1" {
+		t.Errorf("post id not carried over: %+v", sum.Articles)
+	}
+
+	st, _ := state.LoadStage()
+	item, ok := st.Items["posts/renamed.md"]
+	if !ok || item.Snapshot != staged.Snapshot || item.Key != "posts/renamed.md" {
+		t.Errorf("staged item not re-keyed: %+v", st.Items)
+	}
+	if _, ok := st.Items["post.md"]; ok {
+		t.Error("old staged key still present")
+	}
+}
+
+func TestMovePathKeepsArticlesAndPagesApart(t *testing.T) {
+	setupTrackedProject(t)
+	if _, err := state.MovePath("post.md", "pages/post.md"); err == nil || !strings.Contains(err.Error(), "from article to page") {
+		t.Fatalf("expected moving an article into pages/ to be refused, got %v", err)
+	}
+	if _, err := os.Stat("post.md"); err != nil {
+		t.Fatal("refused move touched the file")
+	}
+
+	writeFiles(t, map[string]string{"pages/about.md": "---\ntitle: About\n---\nMe"})
+	sum := mustLoadSum(t)
+	sum.Pages = map[string]state.PageSum{"pages/about.md": {PageID: "page-1"}}
+	if err := state.SaveSum(sum); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := state.MovePath("pages/about.md", "posts/about.md"); err == nil {
+		t.Error("expected moving a page out of pages/ to be refused")
+	}
+	res, err := state.MovePath("pages/about.md", "pages/me.md")
+	if err != nil || res.PostID != "page-1" {
+		t.Fatalf("MovePath = %+v, %v", res, err)
+	}
+	if got := mustLoadSum(t).Pages["pages/me.md"].PageID; got != "page-1" {
+		t.Errorf("page ledger entry not moved: %+v", mustLoadSum(t).Pages)
+	}
+	st, _ := state.LoadStage()
+	if got := st.Items["pages/me.md"].Type; got != state.TypePage {
+		t.Errorf("moved page staged as %s", got)
+	}
+
+	if _, err := state.RemovePaths("pages/me.md", true, false); err != nil {
+		t.Fatal(err)
+	}
+	st, _ = state.LoadStage()
+	if item := st.Items["pages/me.md"]; item.Type != state.TypePage || item.Operation != state.OpDelete {
+		t.Errorf("page deletion staged as %+v", item)
+	}
+}
+
+func TestMovePathAfterExternalMove(t *testing.T) {
+	setupTrackedProject(t)
+	if err := os.Rename("post.md", "moved.md"); err != nil {
+		t.Fatal(err)
+	}
+	res, err := state.MovePath("post.md", "moved.md")
+	if err != nil {
+		t.Fatalf("MovePath failed: %v", err)
+	}
+	if res.FileMoved || res.PostID != "post-1" {
+		t.Errorf("unexpected result: %+v", res)
+	}
+	st, _ := state.LoadStage()
+	if item, ok := st.Items["moved.md"]; !ok || item.Operation != state.OpModify {
+		t.Errorf("moved file not staged: %+v", st.Items)
+	}
+}
+
+func TestMovePathRefusesExistingDestination(t *testing.T) {
+	setupTrackedProject(t)
+	if err := os.WriteFile("other.md", []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := state.MovePath("post.md", "other.md"); err == nil {
+		t.Fatal("expected error for existing destination")
+	}
+	sum, _ := state.LoadSum()
+	if _, ok := sum.Articles["post.md"]; !ok {
+		t.Error("ledger changed despite failed move")
+	}
+}
+
+func TestRemovePaths(t *testing.T) {
+	setupTrackedProject(t)
+	if err := os.WriteFile("draft.md", []byte("---\ntitle: Draft\n---\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := state.StageAdd("draft.md"); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := state.RemovePaths("post.md", true, false)
+	if err != nil || len(removed) != 1 {
+		t.Fatalf("RemovePaths --cached failed: %v %v", removed, err)
+	}
+	if _, err := os.Stat("post.md"); err != nil {
+		t.Error("--cached must keep the local file")
+	}
+
+	// Unpublished drafts are unstaged; tracked files are deleted and staged.
+	if _, err := state.RemovePaths(".", false, true); err != nil {
+		t.Fatalf("RemovePaths failed: %v", err)
+	}
+	for _, p := range []string{"post.md", "draft.md"} {
+		if _, err := os.Stat(p); !os.IsNotExist(err) {
+			t.Errorf("%s not removed", p)
+		}
+	}
+	st, _ := state.LoadStage()
+	if item := st.Items["post.md"]; item.Operation != state.OpDelete {
+		t.Errorf("expected staged delete for post.md, got %+v", item)
+	}
+	if _, ok := st.Items["draft.md"]; ok {
+		t.Error("unpublished draft should be unstaged")
+	}
+
+	// The path no longer exists on disk but still resolves through the ledger.
+	if got := state.TrackedPaths(mustLoadSum(t), "post.md"); len(got) != 1 {
+		t.Errorf("expected ledger match for missing file, got %v", got)
+	}
+}
+
+func TestRemovePathsRefusesToLoseWork(t *testing.T) {
+	setupTrackedProject(t)
+	if err := os.WriteFile("draft.md", []byte("---\ntitle: Draft\n---\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := state.StageAdd("draft.md"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := state.RemovePaths("draft.md", false, false); err == nil || !strings.Contains(err.Error(), "never applied") {
+		t.Errorf("expected a refusal for a never applied file, got %v", err)
+	}
+
+	edited := "---\ntitle: Tracked Post\n---\nUnsaved edit"
+	if err := os.WriteFile("post.md", []byte(edited), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := state.RemovePaths("post.md", false, false); err == nil || !strings.Contains(err.Error(), "neither applied nor staged") {
+		t.Errorf("expected a refusal for unapplied changes, got %v", err)
+	}
+	for _, p := range []string{"post.md", "draft.md"} {
+		if _, err := os.Stat(p); err != nil {
+			t.Errorf("%s removed despite the refusal", p)
+		}
+	}
+	if st, _ := state.LoadStage(); st.Items["post.md"].Operation == state.OpDelete {
+		t.Error("deletion staged despite the refusal")
+	}
+
+	// A working file matching the stage may be removed, as with git rm
+	if err := state.StageAdd("post.md"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := state.RemovePaths("post.md", false, false); err != nil {
+		t.Errorf("staged file refused: %v", err)
+	}
+	if _, err := state.RemovePaths("draft.md", false, true); err != nil {
+		t.Errorf("--force refused: %v", err)
+	}
+	if _, err := os.Stat("draft.md"); !os.IsNotExist(err) {
+		t.Error("--force did not remove the file")
+	}
+}
+
+func mustLoadSum(t *testing.T) *state.Sum {
+	t.Helper()
+	sum, err := state.LoadSum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sum
+}
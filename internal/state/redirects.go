@@ -0,0 +1,89 @@
+package state
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RedirectsFile declares the publication's redirection rules. It lives at the
+// repo root and is staged like an article.
+const RedirectsFile = "redirects.yml"
+
+// Redirect types accepted in redirects.yml.
+const (
+	RedirectPermanent = "permanent"
+	RedirectTemporary = "temporary"
+)
+
+// Redirect is one rule in redirects.yml. Rules are identified by Source.
+type Redirect struct {
+	Source      string `yaml:"source"`
+	Destination string `yaml:"destination"`
+	Type        string `yaml:"type,omitempty"` // permanent (default) or temporary
+}
+
+// RedirectsDoc is the top-level structure of redirects.yml.
+type RedirectsDoc struct {
+	Redirects []Redirect `yaml:"redirects"`
+}
+
+// RedirectsPath returns the path of redirects.yml at the project root.
+func RedirectsPath() string {
+	return filepath.Join(ProjectRootOrCwd(), RedirectsFile)
+}
+
+// ParseRedirects decodes and validates redirects.yml content. Types are
+// normalized to lower case with permanent as the default.
+func ParseRedirects(content []byte) ([]Redirect, error) {
+	var doc RedirectsDoc
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", RedirectsFile, err)
+	}
+	seen := make(map[string]bool)
+	for i := range doc.Redirects {
+		r := &doc.Redirects[i]
+		r.Source = strings.TrimSpace(r.Source)
+		r.Destination = strings.TrimSpace(r.Destination)
+		r.Type = strings.ToLower(strings.TrimSpace(r.Type))
+		if r.Type == "" {
+			r.Type = RedirectPermanent
+		}
+		switch {
+		case !strings.HasPrefix(r.Source, "/"):
+			return nil, fmt.Errorf("%s: rule %d: source must be a path starting with /, got %q", RedirectsFile, i+1, r.Source)
+		case r.Destination == "":
+			return nil, fmt.Errorf("%s: rule %d (%s): destination is required", RedirectsFile, i+1, r.Source)
+		case r.Type != RedirectPermanent && r.Type != RedirectTemporary:
+			return nil, fmt.Errorf("%s: rule %d (%s): type must be %s or %s", RedirectsFile, i+1, r.Source, RedirectPermanent, RedirectTemporary)
+		case seen[r.Source]:
+			return nil, fmt.Errorf("%s: duplicate source %s", RedirectsFile, r.Source)
+		}
+		seen[r.Source] = true
+	}
+	return doc.Redirects, nil
+}
+
+// PagesDir holds the publication's static pages (About, Uses, ...), one
+// markdown file per page.
+const PagesDir = "pages"
+
+// ItemTypeForPath classifies a stage key: redirects.yml and webhooks.yml at
+// the repo root are TypeRedirect and TypeWebhook items, markdown files under
+// pages/ are TypePage, everything else is an article.
+func ItemTypeForPath(key string) ItemType {
+	switch key {
+	case RedirectsFile:
+		return TypeRedirect
+	case WebhooksFile:
+		return TypeWebhook
+	}
+	if strings.HasPrefix(key, PagesDir+"/") {
+		if ext := strings.ToLower(filepath.Ext(key)); ext == ".md" || ext == ".markdown" {
+			return TypePage
+		}
+	}
+	return TypeArticle
+}
@@ -0,0 +1,43 @@
+package state_test
+
+import (
+	"testing"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+func TestParseRedirects(t *testing.T) {
+	rules, err := state.ParseRedirects([]byte("redirects:\n  - source: /old\n    destination: /new\n  - source: /tmp\n    destination: https://example.com/x\n    type: Temporary\n"))
+	if err != nil {
+		t.Fatalf("ParseRedirects failed: %v", err)
+	}
+	if len(rules) != 2 || rules[0].Type != state.RedirectPermanent || rules[1].Type != state.RedirectTemporary {
+		t.Errorf("unexpected rules: %+v", rules)
+	}
+
+	for name, doc := range map[string]string{
+		"relative source": "redirects:\n  - source: old\n    destination: /new\n",
+		"no destination":  "redirects:\n  - source: /old\n",
+		"bad type":        "redirects:\n  - source: /old\n    destination: /new\n    type: moved\n",
+		"duplicate":       "redirects:\n  - source: /a\n    destination: /b\n  - source: /a\n    destination: /c\n",
+	} {
+		if _, err := state.ParseRedirects([]byte(doc)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestItemTypeForPath(t *testing.T) {
+	if got := state.ItemTypeForPath(state.RedirectsFile); got != state.TypeRedirect {
+		t.Errorf("redirects.yml: got %s", got)
+	}
+	if got := state.ItemTypeForPath("posts/redirects.yml"); got != state.TypeArticle {
+		t.Errorf("nested file: got %s", got)
+	}
+	if got := state.ItemTypeForPath("pages/about.md"); got != state.TypePage {
+		t.Errorf("static page: got %s", got)
+	}
+	if got := state.ItemTypeForPath("posts/pages/about.md"); got != state.TypeArticle {
+		t.Errorf("nested pages dir: got %s", got)
+	}
+}
@@ -0,0 +1,140 @@
+package state
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// Sources `hn restore` can take content from.
+const (
+	RestoreFromStage  = "stage"  // The staged snapshot
+	RestoreFromLedger = "ledger" // The content last applied from this repo
+)
+
+// RestoreItem is one working file `hn restore` would overwrite.
+type RestoreItem struct {
+	Path      string
+	Content   []byte
+	Overwrite bool // The working file exists with other content
+}
+
+// PlanRestore resolves each path to the content it would be restored to from
+// source. Paths whose working file already holds that content are returned
+// in upToDate instead. Nothing is written.
+func PlanRestore(paths []string, source string) (todo []RestoreItem, upToDate []string, err error) {
+	if source != RestoreFromStage && source != RestoreFromLedger {
+		return nil, nil, fmt.Errorf("invalid source %q (want stage or ledger)", source)
+	}
+	st, err := LoadStage()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load stage: %w", err)
+	}
+	var sum *Sum
+	if source == RestoreFromLedger {
+		if sum, err = LoadSum(); err != nil {
+			return nil, nil, fmt.Errorf("failed to load hashnode.sum: %w", err)
+		}
+	}
+	for _, p := range paths {
+		path := NormalizePath(p)
+		content, err := RestoreContent(path, source, st, sum)
+		if err != nil {
+			return nil, nil, err
+		}
+		current, rerr := os.ReadFile(absRepoPath(path))
+		if rerr == nil && bytes.Equal(current, content) {
+			upToDate = append(upToDate, path)
+			continue
+		}
+		todo = append(todo, RestoreItem{Path: path, Content: content, Overwrite: rerr == nil})
+	}
+	return todo, upToDate, nil
+}
+
+// RestoreContent returns the content of the normalized path in source.
+func RestoreContent(path, source string, st *Stage, sum *Sum) ([]byte, error) {
+	store := NewSnapshotStore()
+	if source == RestoreFromStage {
+		item, ok := st.Items[path]
+		if !ok {
+			return nil, fmt.Errorf("%s is not staged", path)
+		}
+		if item.Operation == OpDelete || item.Snapshot == "" {
+			return nil, fmt.Errorf("%s is staged for deletion; nothing to restore", path)
+		}
+		return store.Get(item.Snapshot)
+	}
+	checksum := sum.Articles[path].Checksum
+	if checksum == "" {
+		checksum = sum.Pages[path].Checksum
+	}
+	if checksum == "" {
+		return nil, fmt.Errorf("%s is not tracked in hashnode.sum", path)
+	}
+	content, err := store.GetContentByChecksum(checksum)
+	if err != nil {
+		return nil, fmt.Errorf("%s: last published content is not in the snapshot store", path)
+	}
+	return content, nil
+}
+
+// RestoreOverwrites lists the items whose working changes would be
+// discarded; `hn restore` asks before writing them.
+func RestoreOverwrites(items []RestoreItem) []string {
+	var paths []string
+	for _, it := range items {
+		if it.Overwrite {
+			paths = append(paths, it.Path)
+		}
+	}
+	return paths
+}
+
+// ApplyRestore writes each item's content to its working file. The stage and
+// ledger are not changed.
+func ApplyRestore(items []RestoreItem) error {
+	for _, it := range items {
+		if err := AtomicWriteFile(absRepoPath(it.Path), it.Content, FilePerm); err != nil {
+			return fmt.Errorf("failed to write %s: %w", it.Path, err)
+		}
+	}
+	return nil
+}
+
+// Paths returns the staged paths, sorted.
+func (s *Stage) Paths() []string {
+	paths := make([]string, 0, len(s.Items))
+	for p := range s.Items {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// ResetStage unstages everything and returns the paths it unstaged. Working
+// files are not touched. Unless keepSnapshots, snapshots no longer referenced
+// are garbage-collected and the GC stats returned.
+func ResetStage(keepSnapshots bool) ([]string, *GCStats, error) {
+	st, err := LoadStage()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load stage: %w", err)
+	}
+	paths := st.Paths()
+	if len(paths) == 0 {
+		return nil, nil, nil
+	}
+	st.Clear()
+	if err := SaveStage(st); err != nil {
+		return nil, nil, fmt.Errorf("failed to clear stage: %w", err)
+	}
+	if keepSnapshots {
+		return paths, nil, nil
+	}
+	stats, err := NewSnapshotStore().GC(false)
+	if err != nil {
+		return paths, nil, err
+	}
+	return paths, stats, nil
+}
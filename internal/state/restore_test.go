@@ -0,0 +1,129 @@
+package state_test
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+const publishedContent = "---\ntitle: Tracked Post\n---\nBody"
+
+func TestRestoreFromStage(t *testing.T) {
+	setupTrackedProject(t)
+	staged := "---\ntitle: Tracked Post\n---\nStaged edit"
+	if err := os.WriteFile("post.md", []byte(staged), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := state.StageAdd("post.md"); err != nil {
+		t.Fatalf("StageAdd failed: %v", err)
+	}
+
+	todo, upToDate, err := state.PlanRestore([]string{"post.md"}, state.RestoreFromStage)
+	if err != nil || len(todo) != 0 || !reflect.DeepEqual(upToDate, []string{"post.md"}) {
+		t.Fatalf("working file matching the stage: todo=%v upToDate=%v err=%v", todo, upToDate, err)
+	}
+
+	if err := os.WriteFile("post.md", []byte("unsaved work"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	todo, _, err = state.PlanRestore([]string{"post.md"}, state.RestoreFromStage)
+	if err != nil {
+		t.Fatalf("PlanRestore failed: %v", err)
+	}
+	// Planning is the dry run: the working file is untouched
+	if got, _ := os.ReadFile("post.md"); string(got) != "unsaved work" {
+		t.Fatalf("PlanRestore wrote the working file: %q", got)
+	}
+	if got := state.RestoreOverwrites(todo); !reflect.DeepEqual(got, []string{"post.md"}) {
+		t.Fatalf("expected post.md to need confirmation, got %v", got)
+	}
+
+	if err := state.ApplyRestore(todo); err != nil {
+		t.Fatalf("ApplyRestore failed: %v", err)
+	}
+	if got, _ := os.ReadFile("post.md"); string(got) != staged {
+		t.Errorf("restored content = %q, want %q", got, staged)
+	}
+	if st, _ := state.LoadStage(); len(st.Items) != 1 {
+		t.Errorf("restore changed the stage: %v", st.Items)
+	}
+}
+
+func TestRestoreFromLedger(t *testing.T) {
+	setupTrackedProject(t)
+	if _, err := state.NewSnapshotStore().Create([]byte(publishedContent)); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove("post.md"); err != nil {
+		t.Fatal(err)
+	}
+
+	todo, _, err := state.PlanRestore([]string{"post.md"}, state.RestoreFromLedger)
+	if err != nil || len(todo) != 1 {
+		t.Fatalf("PlanRestore: todo=%v err=%v", todo, err)
+	}
+	// A missing file is recreated without asking
+	if got := state.RestoreOverwrites(todo); len(got) != 0 {
+		t.Errorf("expected no confirmation for a missing file, got %v", got)
+	}
+	if err := state.ApplyRestore(todo); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := os.ReadFile("post.md"); string(got) != publishedContent {
+		t.Errorf("restored content = %q", got)
+	}
+
+	if _, _, err := state.PlanRestore([]string{"post.md"}, state.RestoreFromStage); err == nil {
+		t.Error("expected an error restoring an unstaged file from the stage")
+	}
+	if _, _, err := state.PlanRestore([]string{"other.md"}, state.RestoreFromLedger); err == nil {
+		t.Error("expected an error restoring an untracked file from the ledger")
+	}
+	if _, _, err := state.PlanRestore([]string{"post.md"}, "remote"); err == nil {
+		t.Error("expected an error for an unknown source")
+	}
+}
+
+func TestResetStageOnlyUnstages(t *testing.T) {
+	setupTrackedProject(t)
+	edit := "---\ntitle: Tracked Post\n---\nStaged edit"
+	if err := os.WriteFile("post.md", []byte(edit), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := state.StageAdd("post.md"); err != nil {
+		t.Fatal(err)
+	}
+	st, _ := state.LoadStage()
+	snapshot := st.Items["post.md"].Snapshot
+
+	paths, stats, err := state.ResetStage(true)
+	if err != nil || !reflect.DeepEqual(paths, []string{"post.md"}) || stats != nil {
+		t.Fatalf("ResetStage(keep) = %v, %v, %v", paths, stats, err)
+	}
+	if st, _ := state.LoadStage(); len(st.Items) != 0 {
+		t.Fatalf("stage not cleared: %v", st.Items)
+	}
+	if got, _ := os.ReadFile("post.md"); string(got) != edit {
+		t.Errorf("reset changed the working file: %q", got)
+	}
+	if !state.NewSnapshotStore().Exists(snapshot) {
+		t.Fatal("snapshot removed despite keepSnapshots")
+	}
+
+	if err := state.StageAdd("post.md"); err != nil {
+		t.Fatal(err)
+	}
+	paths, stats, err = state.ResetStage(false)
+	if err != nil || len(paths) != 1 || stats == nil || stats.RemovedCount != 1 {
+		t.Fatalf("ResetStage = %v, %+v, %v", paths, stats, err)
+	}
+	if state.NewSnapshotStore().Exists(snapshot) {
+		t.Error("unreferenced snapshot kept after reset")
+	}
+
+	if paths, _, err := state.ResetStage(false); err != nil || len(paths) != 0 {
+		t.Errorf("reset of an empty stage = %v, %v", paths, err)
+	}
+}
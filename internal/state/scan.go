@@ -14,7 +14,7 @@ import (
 // LocalPost represents a raw markdown file found on disk
 type LocalPost struct {
 	Path     string
-	Slug     string // Derived from filename (e.g. "posts/hello.md" -> "hello")
+	Slug     string // Derived from filename (e.g. "posts/hello.md" -> "hello", "posts/hello/index.md" -> "hello")
 	Checksum string // SHA256 hash of content
 	Content  string // The actual text content
 }
@@ -23,15 +23,9 @@ type LocalPost struct {
 func ScanDirectory(root string) (map[string]LocalPost, error) {
 	posts := make(map[string]LocalPost)
 
-	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
-		if err != nil {
-			return err
-		}
-		// Ignore hidden directories (.git, .hashnode, node_modules)
-		if d.IsDir() && strings.HasPrefix(d.Name(), ".") && d.Name() != "." {
-			return filepath.SkipDir
-		}
-		if d.IsDir() || strings.ToLower(filepath.Ext(path)) != ".md" {
+	// Hidden directories (.git, .hashnode) and .hnignore rules are applied by WalkContent
+	err := WalkContent(root, func(path string, d fs.DirEntry) error {
+		if strings.ToLower(filepath.Ext(path)) != ".md" {
 			return nil
 		}
 		// Calculate Hash & Read Content
@@ -39,9 +33,12 @@ func ScanDirectory(root string) (map[string]LocalPost, error) {
 		if err != nil {
 			return fmt.Errorf("scan error %s: %w", path, err)
 		}
-		// Derive slug from filename
+		// Derive slug from filename, or from the directory of a page bundle
 		filename := filepath.Base(path)
 		slug := strings.TrimSuffix(filename, filepath.Ext(filename))
+		if IsBundleIndex(path) {
+			slug = filepath.Base(filepath.Dir(path))
+		}
 		posts[slug] = LocalPost{
 			Path:     path,
 			Slug:     slug,
@@ -166,7 +166,9 @@ type GCStats struct {
 }
 
 // GC removes unreferenced snapshots with optional integrity verification.
-// A snapshot is considered referenced if it appears in stage or lock.
+// A snapshot is considered referenced if it appears in stage, a stash or lock, or holds
+// the last applied content of an article in the ledger or one of its latest
+// HistoryRetention publishes.
 // In dry-run mode, no files are deleted but stats show what would be removed.
 func (s *SnapshotStore) GC(dryRun bool) (*GCStats, error) {
 	stats := &GCStats{
@@ -186,9 +188,12 @@ func (s *SnapshotStore) GC(dryRun bool) (*GCStats, error) {
 		return stats, nil
 	}
 
-	// Build reference set from stage and lock
-	referenced := s.buildReferenceSet()
-	stats.ReferencedCount = len(referenced)
+	// Build reference set from stage, lock and ledger
+	referenced, err := s.buildReferenceSet()
+	if err != nil {
+		return stats, fmt.Errorf("not collecting snapshots: %w", err)
+	}
+	stats.ReferencedCount = countReferenced(allSnapshots, referenced)
 
 	// Early return if all snapshots are referenced
 	if stats.ReferencedCount >= stats.TotalSnapshots {
@@ -220,30 +225,83 @@ func (s *SnapshotStore) GC(dryRun bool) (*GCStats, error) {
 	return stats, nil
 }
 
-// buildReferenceSet collects all snapshot references from stage and lock.
-func (s *SnapshotStore) buildReferenceSet() map[string]bool {
+// buildReferenceSet collects all snapshot references from stage, stashes,
+// lock and ledger. A source that exists but cannot be read is an error: GC
+// must not delete what it may reference. A missing source references nothing.
+func (s *SnapshotStore) buildReferenceSet() (map[string]bool, error) {
 	referenced := make(map[string]bool)
 
 	// Collect from stage
-	if st, err := LoadStage(); err == nil {
-		for _, item := range st.Items {
+	st, err := LoadStage()
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range st.Items {
+		if item.Snapshot != "" {
+			// Normalize to lowercase for case-insensitive comparison
+			referenced[strings.ToLower(item.Snapshot)] = true
+		}
+	}
+
+	// Collect last applied content from the ledger (used for rename detection and diff)
+	sum, err := LoadSum()
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if sum != nil {
+		for _, a := range sum.Articles {
+			if a.Checksum != "" {
+				referenced[strings.ToLower(a.Checksum)+".md"] = true
+			}
+			// Recent publishes stay available to `hn show`
+			for _, h := range a.History[max(len(a.History)-HistoryRetention, 0):] {
+				referenced[strings.ToLower(h.Checksum)+".md"] = true
+			}
+		}
+		for _, p := range sum.Pages {
+			if p.Checksum != "" {
+				referenced[strings.ToLower(p.Checksum)+".md"] = true
+			}
+		}
+	}
+
+	// Collect from stashes
+	stashes, err := ListStashes()
+	if err != nil {
+		return nil, err
+	}
+	for _, stash := range stashes {
+		for _, item := range stash.Items {
 			if item.Snapshot != "" {
-				// Normalize to lowercase for case-insensitive comparison
 				referenced[strings.ToLower(item.Snapshot)] = true
 			}
 		}
 	}
 
 	// Collect from lock (if exists)
-	if lock, err := LoadLock(); err == nil {
-		for _, article := range lock.Staged.Articles {
-			if article.Snapshot != "" {
-				referenced[strings.ToLower(article.Snapshot)] = true
-			}
+	lock, err := LoadLock()
+	if err != nil {
+		return nil, err
+	}
+	for _, article := range lock.Staged.Articles {
+		if article.Snapshot != "" {
+			referenced[strings.ToLower(article.Snapshot)] = true
 		}
 	}
 
-	return referenced
+	return referenced, nil
+}
+
+// countReferenced counts the snapshots on disk that are referenced. References
+// may name snapshots that no longer exist (e.g. ledger entries from import).
+func countReferenced(snapshots []string, referenced map[string]bool) int {
+	n := 0
+	for _, filename := range snapshots {
+		if referenced[strings.ToLower(filename)] {
+			n++
+		}
+	}
+	return n
 }
 
 // GCWithVerification removes unreferenced snapshots and optionally verifies integrity.
@@ -265,8 +323,11 @@ func (s *SnapshotStore) GCWithVerification(dryRun, verify bool) (*GCStats, error
 	}
 
 	// Build reference set
-	referenced := s.buildReferenceSet()
-	stats.ReferencedCount = len(referenced)
+	referenced, err := s.buildReferenceSet()
+	if err != nil {
+		return stats, fmt.Errorf("not collecting snapshots: %w", err)
+	}
+	stats.ReferencedCount = countReferenced(allSnapshots, referenced)
 
 	// Process snapshots
 	for _, filename := range allSnapshots {
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bmatcuk/doublestar/v4"
 	"github.com/google/uuid"
 )
 
@@ -17,8 +18,11 @@ import (
 type ItemType string
 
 const (
-	TypeArticle ItemType = "ARTICLE"
-	TypeSeries  ItemType = "SERIES"
+	TypeArticle  ItemType = "ARTICLE"
+	TypeSeries   ItemType = "SERIES"
+	TypePage     ItemType = "PAGE"     // Static page under pages/
+	TypeRedirect ItemType = "REDIRECT" // redirects.yml
+	TypeWebhook  ItemType = "WEBHOOK"  // webhooks.yml
 )
 
 // Operation explicitly tracks intent
@@ -90,77 +94,247 @@ func (s *Stage) Clear() {
 // StageDir walks `dir` and stages tracked markdown files using BULK IO.
 // This is O(1) IO operation on the stage file, regardless of file count.
 func StageDir(dir string) ([]string, []string, error) {
-	// 1. Load Stage ONCE
-	st, err := LoadStage()
+	sel, err := SelectStageFiles([]string{dir}, StageFilter{})
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := StageFiles(sel.Files); err != nil {
+		return nil, sel.Skipped, err
+	}
+	staged := make([]string, len(sel.Files))
+	for i, f := range sel.Files {
+		staged[i] = NormalizePath(f)
+	}
+	return staged, sel.Skipped, nil
+}
 
-	snapStore := NewSnapshotStore()
+// StageFilter narrows which files SelectStageFiles picks.
+type StageFilter struct {
+	// ChangedOnly skips files whose content matches their hashnode.sum
+	// checksum or their staged snapshot.
+	ChangedOnly bool
+	// IncludeUntracked also picks files missing from hashnode.sum when
+	// ChangedOnly is set; without ChangedOnly they are always picked.
+	IncludeUntracked bool
+}
 
-	var staged []string
-	var skipped []string
+// StageSelection is the outcome of SelectStageFiles.
+type StageSelection struct {
+	Files     []string // Paths to stage, as given or walked
+	Unchanged []string // Matched the ledger or stage (ChangedOnly)
+	Reverted  []string // Staged with other content but matching the ledger again; unstaged by StageSelected
+	Untracked []string // Not in hashnode.sum (ChangedOnly without IncludeUntracked)
+	Skipped   []string // Not Hashnode content or outside the repo
+}
 
-	// 2. Process Files
-	err = filepath.WalkDir(dir, func(p string, d os.DirEntry, err error) error {
+// ExpandStagePaths replaces glob patterns (including ** for any number of
+// directories) with the paths they match. Only content files (see
+// IsContentPath) and directories are kept from a match, so a pattern like
+// posts/** does not pick up images, and matches are filtered by .hnignore
+// and content.include like a directory walk. Other arguments pass through;
+// a file named explicitly is staged even when ignored.
+func ExpandStagePaths(args []string) ([]string, error) {
+	var paths []string
+	ig := NewIgnorer()
+	for _, arg := range args {
+		if !strings.ContainsAny(arg, "*?[{") {
+			paths = append(paths, arg)
+			continue
+		}
+		matches, err := doublestar.FilepathGlob(arg)
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("invalid pattern %q: %w", arg, err)
 		}
-		if d.IsDir() {
-			// Skip .hashnode state directory entirely
-			if d.Name() == StateDir {
-				return filepath.SkipDir
+		var kept []string
+		for _, m := range matches {
+			info, err := os.Stat(m)
+			if err != nil {
+				continue
+			}
+			if ig.IsIgnored(m, info.IsDir()) {
+				continue
+			}
+			if info.IsDir() || IsContentPath(NormalizePath(m)) {
+				kept = append(kept, m)
 			}
-			return nil
 		}
-		ext := strings.ToLower(filepath.Ext(p))
-		if ext != ".md" && ext != ".markdown" {
-			skipped = append(skipped, p)
-			return nil
+		if len(kept) == 0 {
+			return nil, fmt.Errorf("no content files match %s", arg)
+		}
+		paths = append(paths, kept...)
+	}
+	return paths, nil
+}
+
+// IsContentPath reports whether the repo-relative key names a file hn can
+// stage: markdown, redirects.yml or webhooks.yml.
+func IsContentPath(key string) bool {
+	switch ItemTypeForPath(key) {
+	case TypeRedirect, TypeWebhook:
+		return true
+	}
+	return isMarkdownPath(key)
+}
+
+// SelectStageFiles resolves files and directories to the files that should
+// be staged. Directories are walked for markdown, redirects.yml and
+// webhooks.yml, leaving out ignored paths (see WalkContent); files named
+// explicitly are always candidates. Each file is picked at most once.
+func SelectStageFiles(paths []string, f StageFilter) (*StageSelection, error) {
+	sel := &StageSelection{}
+	var sum *Sum
+	var st *Stage
+	if f.ChangedOnly {
+		var err error
+		if sum, err = LoadSum(); err != nil {
+			if !os.IsNotExist(err) {
+				return nil, err
+			}
+			sum = &Sum{}
+		}
+		if st, err = LoadStage(); err != nil {
+			return nil, err
 		}
+	}
 
+	seen := make(map[string]bool)
+	consider := func(p string) error {
+		key := NormalizePath(p)
+		if seen[key] {
+			return nil
+		}
+		seen[key] = true
 		absPath, err := filepath.Abs(p)
-		if err != nil || !inRepo(absPath) {
-			skipped = append(skipped, p)
+		if err != nil || !inRepo(absPath) || isInStateDir(absPath) {
+			sel.Skipped = append(sel.Skipped, p)
+			return nil
+		}
+		if !f.ChangedOnly {
+			sel.Files = append(sel.Files, p)
 			return nil
 		}
-
-		// Read content and create snapshot
 		content, err := os.ReadFile(absPath)
 		if err != nil {
-			skipped = append(skipped, p)
+			return fmt.Errorf("failed to read %s: %w", p, err)
+		}
+		checksum := ChecksumFromContent(content)
+		if item, ok := st.Items[key]; ok && item.Operation == OpModify && item.Checksum == checksum {
+			sel.Unchanged = append(sel.Unchanged, p)
 			return nil
 		}
+		ledger, tracked := ledgerChecksum(sum, key)
+		switch {
+		case !tracked && !f.IncludeUntracked:
+			sel.Untracked = append(sel.Untracked, p)
+		case tracked && ledger == checksum:
+			sel.Unchanged = append(sel.Unchanged, p)
+			// An older staged version would otherwise be applied
+			if item, ok := st.Items[key]; ok && item.Operation == OpModify {
+				sel.Reverted = append(sel.Reverted, key)
+			}
+		default:
+			sel.Files = append(sel.Files, p)
+		}
+		return nil
+	}
 
-		snap, err := snapStore.Create(content)
+	for _, p := range paths {
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, fmt.Errorf("path does not exist: %s", p)
+		}
+		if !info.IsDir() {
+			if err := consider(p); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		err = WalkContent(p, func(path string, d os.DirEntry) error {
+			if !IsContentPath(NormalizePath(path)) {
+				sel.Skipped = append(sel.Skipped, path)
+				return nil
+			}
+			return consider(path)
+		})
 		if err != nil {
+			return nil, err
+		}
+	}
+	return sel, nil
+}
+
+// ledgerChecksum returns the last applied checksum of an article or page.
+// redirects.yml and webhooks.yml have no file checksum in the ledger; they
+// count as tracked and changed unless already staged as they are.
+func ledgerChecksum(sum *Sum, key string) (string, bool) {
+	switch ItemTypeForPath(key) {
+	case TypeRedirect, TypeWebhook:
+		return "", true
+	case TypePage:
+		p, ok := sum.Pages[key]
+		return p.Checksum, ok
+	}
+	a, ok := sum.Articles[key]
+	return a.Checksum, ok
+}
+
+// StageSelected stages sel.Files and unstages sel.Reverted.
+func StageSelected(sel *StageSelection) error {
+	if len(sel.Files) > 0 {
+		if err := StageFiles(sel.Files); err != nil {
 			return err
 		}
+	}
+	if len(sel.Reverted) == 0 {
+		return nil
+	}
+	st, err := LoadStage()
+	if err != nil {
+		return err
+	}
+	for _, key := range sel.Reverted {
+		delete(st.Items, key)
+	}
+	return SaveStage(st)
+}
 
-		// Update Memory
+// StageFiles snapshots and stages every file with a single stage write.
+func StageFiles(files []string) error {
+	st, err := LoadStage()
+	if err != nil {
+		return err
+	}
+	snapStore := NewSnapshotStore()
+	for _, p := range files {
+		absPath, err := filepath.Abs(p)
+		if err != nil {
+			return fmt.Errorf("invalid path: %w", err)
+		}
+		if !inRepo(absPath) {
+			return fmt.Errorf("path is outside repository: %s", p)
+		}
+		if isInStateDir(absPath) {
+			return fmt.Errorf("cannot stage files from %s directory", StateDir)
+		}
+		content, err := os.ReadFile(absPath)
+		if err != nil {
+			return fmt.Errorf("failed to read file: %w", err)
+		}
+		snap, err := snapStore.Create(content)
+		if err != nil {
+			return err
+		}
 		key := NormalizePath(p)
 		st.Items[key] = StagedItem{
-			Type:      TypeArticle,
+			Type:      ItemTypeForPath(key),
 			Key:       key,
 			Operation: OpModify,
 			Checksum:  snap.Checksum,
 			Snapshot:  snap.Filename,
 			StagedAt:  time.Now(),
 		}
-		staged = append(staged, key)
-		return nil
-	})
-
-	if err != nil {
-		return staged, skipped, err
 	}
-
-	// 3. Save Stage ONCE
-	if err := SaveStage(st); err != nil {
-		return staged, skipped, err
-	}
-
-	return staged, skipped, nil
+	return SaveStage(st)
 }
 
 // StageAdd adds or updates a file in the stage (Single file).
@@ -200,7 +374,7 @@ func StageAdd(path string) error {
 
 	key := NormalizePath(path)
 	st.Items[key] = StagedItem{
-		Type:      TypeArticle,
+		Type:      ItemTypeForPath(key),
 		Key:       key,
 		Operation: OpModify,
 		Checksum:  snap.Checksum,
@@ -223,7 +397,7 @@ func StageRemove(path string) error {
 
 	key := NormalizePath(path)
 	st.Items[key] = StagedItem{
-		Type:      TypeArticle,
+		Type:      ItemTypeForPath(key),
 		Key:       key,
 		Operation: OpDelete,
 		StagedAt:  time.Now(),
@@ -306,19 +480,46 @@ func GenerateFilename(title string, dir string) (string, error) {
 	if base == "" {
 		base = uuid.NewString()[0:8]
 	}
+	return UniquePath(filepath.Join(dir, base+".md"))
+}
+
+// UniquePath returns p, or p with "-1", "-2", ... before its extension when
+// a file already exists there. For a page bundle (p ends in index.md) the
+// directory gets the suffix instead, so each post keeps its own directory.
+func UniquePath(p string) (string, error) {
+	return UniqueUnclaimedPath(p, nil)
+}
 
-	name := base + ".md"
-	full := filepath.Join(dir, name)
-	i := 1
-	for {
-		if _, err := os.Stat(full); os.IsNotExist(err) {
+// UniqueUnclaimedPath is UniquePath that also skips the slash-separated paths
+// in claimed, e.g. those handed out earlier in a dry run that writes nothing.
+func UniqueUnclaimedPath(p string, claimed map[string]bool) (string, error) {
+	candidate := func(i int) string {
+		ext := filepath.Ext(p)
+		stem := strings.TrimSuffix(p, ext)
+		return fmt.Sprintf("%s-%d%s", stem, i, ext)
+	}
+	if IsBundleIndex(p) {
+		dir, name := filepath.Split(p)
+		dir = filepath.Clean(dir)
+		candidate = func(i int) string {
+			return filepath.Join(fmt.Sprintf("%s-%d", dir, i), name)
+		}
+	}
+	full := p
+	for i := 1; ; i++ {
+		if _, err := os.Stat(full); os.IsNotExist(err) && !claimed[filepath.ToSlash(full)] {
 			return filepath.ToSlash(full), nil
 		}
-		name = fmt.Sprintf("%s-%d.md", base, i)
-		full = filepath.Join(dir, name)
-		i++
 		if i > 1000 {
-			return "", fmt.Errorf("too many filename collisions for %s", base)
+			return "", fmt.Errorf("too many filename collisions for %s", filepath.ToSlash(p))
 		}
+		full = candidate(i)
 	}
 }
+
+// IsBundleIndex reports whether p is the index file of a page bundle, a
+// directory holding one post and its assets.
+func IsBundleIndex(p string) bool {
+	name := strings.ToLower(filepath.Base(p))
+	return (name == "index.md" || name == "index.markdown") && filepath.Dir(p) != "."
+}
@@ -0,0 +1,200 @@
+package state_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+func TestSelectStageFilesChangedOnly(t *testing.T) {
+	setupTrackedProject(t)
+	if err := os.MkdirAll(filepath.Join("posts", "go"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"posts/go/go-edited.md": "---\ntitle: Edited\n---\nNew body",
+		"posts/go/go-new.md":    "---\ntitle: New\n---\nBody",
+		"posts/notes.md":        "---\ntitle: Notes\n---\nBody",
+	}
+	for p, c := range files {
+		if err := os.WriteFile(p, []byte(c), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sum := mustLoadSum(t)
+	sum.SetArticle("posts/go/go-edited.md", "post-2", state.ChecksumFromContent([]byte("old")), "edited")
+	if err := state.SaveSum(sum); err != nil {
+		t.Fatal(err)
+	}
+
+	normalize := func(paths []string) []string {
+		out := make([]string, 0, len(paths))
+		for _, p := range paths {
+			out = append(out, state.NormalizePath(p))
+		}
+		sort.Strings(out)
+		return out
+	}
+
+	sel, err := state.SelectStageFiles([]string{"."}, state.StageFilter{ChangedOnly: true})
+	if err != nil {
+		t.Fatalf("SelectStageFiles failed: %v", err)
+	}
+	if got, want := normalize(sel.Files), []string{"posts/go/go-edited.md"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Files = %v, want %v", got, want)
+	}
+	if got, want := normalize(sel.Unchanged), []string{"post.md"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Unchanged = %v, want %v", got, want)
+	}
+	if got, want := normalize(sel.Untracked), []string{"posts/go/go-new.md", "posts/notes.md"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Untracked = %v, want %v", got, want)
+	}
+
+	sel, err = state.SelectStageFiles([]string{"posts"}, state.StageFilter{ChangedOnly: true, IncludeUntracked: true})
+	if err != nil {
+		t.Fatalf("SelectStageFiles failed: %v", err)
+	}
+	if got := normalize(sel.Files); len(got) != 3 {
+		t.Errorf("expected 3 files with untracked included, got %v", got)
+	}
+
+	// Once staged, an unchanged working file is no longer picked
+	if err := state.StageFiles([]string{"posts/go/go-edited.md"}); err != nil {
+		t.Fatalf("StageFiles failed: %v", err)
+	}
+	sel, err = state.SelectStageFiles([]string{"posts/go/go-edited.md"}, state.StageFilter{ChangedOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sel.Files) != 0 || len(sel.Unchanged) != 1 {
+		t.Errorf("expected the staged file to be unchanged, got %+v", sel)
+	}
+}
+
+func TestChangedOnlyUnstagesFilesBackToTheLedger(t *testing.T) {
+	setupTrackedProject(t)
+	published, err := os.ReadFile("post.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile("post.md", []byte("---\ntitle: Tracked Post\n---\nEdit"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := state.StageAdd("post.md"); err != nil {
+		t.Fatal(err)
+	}
+	// The edit is undone in the working tree
+	if err := os.WriteFile("post.md", published, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	sel, err := state.SelectStageFiles([]string{"."}, state.StageFilter{ChangedOnly: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(sel.Reverted, []string{"post.md"}) || len(sel.Files) != 0 {
+		t.Fatalf("selection = %+v", sel)
+	}
+	if err := state.StageSelected(sel); err != nil {
+		t.Fatalf("StageSelected failed: %v", err)
+	}
+	if st, _ := state.LoadStage(); len(st.Items) != 0 {
+		t.Errorf("stale staged item kept: %+v", st.Items)
+	}
+}
+
+func TestExpandStagePaths(t *testing.T) {
+	setupTrackedProject(t)
+	for _, p := range []string{"posts/go/deep/go-a.md", "posts/go-b.md", "posts/rust-c.md"} {
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte("body"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := state.ExpandStagePaths([]string{"posts/**/go-*.md", "post.md"})
+	if err != nil {
+		t.Fatalf("ExpandStagePaths failed: %v", err)
+	}
+	for i := range got {
+		got[i] = filepath.ToSlash(got[i])
+	}
+	sort.Strings(got)
+	want := []string{"post.md", "posts/go-b.md", "posts/go/deep/go-a.md"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpandStagePaths = %v, want %v", got, want)
+	}
+
+	if _, err := state.ExpandStagePaths([]string{"drafts/*.md"}); err == nil {
+		t.Error("expected an error for a pattern matching nothing")
+	}
+}
+
+func TestStageGlobKeepsOnlyContentFiles(t *testing.T) {
+	setupTrackedProject(t)
+	writeFiles(t, map[string]string{
+		"posts/a.md":          "---\ntitle: A\n---\nBody",
+		"posts/img.png":       "png",
+		"posts/sub/b.md":      "---\ntitle: B\n---\nBody",
+		"posts/sub/data.json": "{}",
+	})
+
+	paths, err := state.ExpandStagePaths([]string{"posts/**"})
+	if err != nil {
+		t.Fatalf("ExpandStagePaths failed: %v", err)
+	}
+	sel, err := state.SelectStageFiles(paths, state.StageFilter{})
+	if err != nil {
+		t.Fatalf("SelectStageFiles failed: %v", err)
+	}
+	var got []string
+	for _, p := range sel.Files {
+		got = append(got, state.NormalizePath(p))
+	}
+	sort.Strings(got)
+	if want := []string{"posts/a.md", "posts/sub/b.md"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("staged %v, want %v", got, want)
+	}
+
+	if _, err := state.ExpandStagePaths([]string{"posts/*.png"}); err == nil {
+		t.Error("expected an error for a pattern matching no content files")
+	}
+}
+
+func TestUniquePathSuffixesBundleDirectories(t *testing.T) {
+	setupTrackedProject(t)
+	writeFiles(t, map[string]string{"posts/hello.md": "x", "posts/go/index.md": "x"})
+
+	cases := map[string]string{
+		"posts/hello.md":    "posts/hello-1.md",
+		"posts/new.md":      "posts/new.md",
+		"posts/go/index.md": "posts/go-1/index.md",
+	}
+	for in, want := range cases {
+		got, err := state.UniquePath(filepath.FromSlash(in))
+		if err != nil || got != want {
+			t.Errorf("UniquePath(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+}
+
+func TestUniqueUnclaimedPathSkipsClaimedPaths(t *testing.T) {
+	setupTrackedProject(t)
+	writeFiles(t, map[string]string{"posts/hello.md": "x"})
+
+	// Nothing is written in between, as in `hn import --dry-run`
+	claimed := make(map[string]bool)
+	for _, want := range []string{"posts/hello-1.md", "posts/hello-2.md"} {
+		got, err := state.UniqueUnclaimedPath(filepath.FromSlash("posts/hello.md"), claimed)
+		if err != nil || got != want {
+			t.Fatalf("UniqueUnclaimedPath = %q, %v; want %q", got, err, want)
+		}
+		claimed[got] = true
+	}
+}
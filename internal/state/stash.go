@@ -0,0 +1,211 @@
+package state
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Stash is a set of staged items set aside with `hn stash push`. Its
+// snapshots stay referenced for GC until the stash is popped or dropped.
+type Stash struct {
+	Name      string                `yaml:"name"`
+	Message   string                `yaml:"message,omitempty"`
+	CreatedAt time.Time             `yaml:"created_at"`
+	Items     map[string]StagedItem `yaml:"items"`
+}
+
+// StashConflictError reports stashed items that were changed since the
+// stash was pushed: staged again with different content or intent, or
+// published with different content.
+type StashConflictError struct {
+	Name    string
+	Paths   []string // Staged since the push
+	Applied []string // Applied since the push
+}
+
+func (e *StashConflictError) Error() string {
+	var parts []string
+	if len(e.Paths) > 0 {
+		parts = append(parts, "staged changes to "+strings.Join(e.Paths, ", "))
+	}
+	if len(e.Applied) > 0 {
+		parts = append(parts, "changes applied since the push to "+strings.Join(e.Applied, ", "))
+	}
+	return fmt.Sprintf("stash %s conflicts with %s", e.Name, strings.Join(parts, " and "))
+}
+
+var stashNameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// stashPath returns the file of the named stash. Names are checked here so
+// no entry point can reach a file outside the stash directory.
+func stashPath(name string) (string, error) {
+	if !stashNameRe.MatchString(name) {
+		return "", fmt.Errorf("invalid stash name %q (letters, digits, '.', '_' and '-')", name)
+	}
+	return StatePath(StashDir, name+StateFileExt), nil
+}
+
+// StashPush moves every staged item into a new stash and clears the stage.
+// An empty name is replaced by one derived from the current time.
+func StashPush(name, message string) (*Stash, error) {
+	now := time.Now().UTC()
+	if name == "" {
+		name = "stash-" + now.Format("20060102-150405")
+	}
+	path, err := stashPath(name)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(path); err == nil {
+		return nil, fmt.Errorf("stash %s already exists", name)
+	}
+	st, err := LoadStage()
+	if err != nil {
+		return nil, err
+	}
+	if len(st.Items) == 0 {
+		return nil, fmt.Errorf("nothing staged to stash")
+	}
+
+	stash := &Stash{Name: name, Message: message, CreatedAt: now, Items: st.Items}
+	if err := os.MkdirAll(StatePath(StashDir), DirPerm); err != nil {
+		return nil, err
+	}
+	// Write the stash before clearing the stage so a failure loses nothing
+	if err := WriteYAML(path, stash); err != nil {
+		return nil, err
+	}
+	st.Clear()
+	if err := SaveStage(st); err != nil {
+		return nil, err
+	}
+	return stash, nil
+}
+
+// ListStashes returns the stashes, newest first.
+func ListStashes() ([]Stash, error) {
+	files, err := filepath.Glob(StatePath(StashDir, "*"+StateFileExt))
+	if err != nil {
+		return nil, err
+	}
+	var stashes []Stash
+	for _, f := range files {
+		var s Stash
+		if err := ReadYAML(f, &s); err != nil {
+			return nil, fmt.Errorf("failed to read stash %s: %w", filepath.Base(f), err)
+		}
+		stashes = append(stashes, s)
+	}
+	sort.Slice(stashes, func(i, j int) bool {
+		if !stashes[i].CreatedAt.Equal(stashes[j].CreatedAt) {
+			return stashes[i].CreatedAt.After(stashes[j].CreatedAt)
+		}
+		return stashes[i].Name < stashes[j].Name
+	})
+	return stashes, nil
+}
+
+// LoadStash reads the named stash, or the newest one when name is empty.
+func LoadStash(name string) (*Stash, error) {
+	if name == "" {
+		stashes, err := ListStashes()
+		if err != nil {
+			return nil, err
+		}
+		if len(stashes) == 0 {
+			return nil, fmt.Errorf("no stashes")
+		}
+		return &stashes[0], nil
+	}
+	path, err := stashPath(name)
+	if err != nil {
+		return nil, err
+	}
+	var s Stash
+	if err := ReadYAML(path, &s); err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no stash named %s", name)
+		}
+		return nil, fmt.Errorf("failed to read stash %s: %w", name, err)
+	}
+	return &s, nil
+}
+
+// StashPop restores a stash (the newest when name is empty) into the stage
+// and drops it. Items staged since the push that differ from the stashed
+// ones are conflicts, and so are articles applied since the push with other
+// content (popping would stage the older content over the published fix).
+// Conflicts abort the pop with a *StashConflictError unless force is set, in
+// which case the stashed items win.
+func StashPop(name string, force bool) (*Stash, error) {
+	stash, err := LoadStash(name)
+	if err != nil {
+		return nil, err
+	}
+	st, err := LoadStage()
+	if err != nil {
+		return nil, err
+	}
+	sum, err := LoadSum()
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to load hashnode.sum: %w", err)
+	}
+
+	conflict := &StashConflictError{Name: stash.Name}
+	for key, item := range stash.Items {
+		if cur, ok := st.Items[key]; ok && (cur.Operation != item.Operation || cur.Checksum != item.Checksum) {
+			conflict.Paths = append(conflict.Paths, key)
+		} else if sum != nil && appliedSince(sum.Articles[key], stash.CreatedAt, item) {
+			conflict.Applied = append(conflict.Applied, key)
+		}
+	}
+	if len(conflict.Paths)+len(conflict.Applied) > 0 && !force {
+		sort.Strings(conflict.Paths)
+		sort.Strings(conflict.Applied)
+		return nil, conflict
+	}
+
+	for key, item := range stash.Items {
+		st.Items[key] = item
+	}
+	if err := SaveStage(st); err != nil {
+		return nil, err
+	}
+	return stash, DropStash(stash.Name)
+}
+
+// appliedSince reports whether the article was published at or after t with
+// content other than the stashed item's. Publish times are kept to the
+// second, so a publish in the same second as t counts as after it.
+func appliedSince(entry ArticleSum, t time.Time, item StagedItem) bool {
+	if entry.Checksum == item.Checksum && item.Operation != OpDelete {
+		return false
+	}
+	since := t.Truncate(time.Second)
+	for _, h := range entry.History {
+		if !h.AppliedAt.Before(since) {
+			return true
+		}
+	}
+	return false
+}
+
+// DropStash deletes the named stash.
+func DropStash(name string) error {
+	path, err := stashPath(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil {
+		if os.IsNotExist(err) {
+			return fmt.Errorf("no stash named %s", name)
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package state_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+func TestStashPushPopRoundTrip(t *testing.T) {
+	setupTrackedProject(t)
+	if err := os.WriteFile("post.md", []byte("---\ntitle: Tracked Post\n---\nStashed edit"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := state.StageAdd("post.md"); err != nil {
+		t.Fatalf("StageAdd failed: %v", err)
+	}
+	staged, _ := state.LoadStage()
+	item := staged.Items["post.md"]
+
+	if _, err := state.StashPush("wip", ""); err != nil {
+		t.Fatalf("StashPush failed: %v", err)
+	}
+	if st, _ := state.LoadStage(); len(st.Items) != 0 {
+		t.Fatalf("expected empty stage after push, got %d item(s)", len(st.Items))
+	}
+	if _, err := state.StashPush("other", ""); err == nil {
+		t.Error("expected pushing an empty stage to fail")
+	}
+
+	// The stashed snapshot survives GC while nothing else references it
+	if _, err := state.NewSnapshotStore().GC(false); err != nil {
+		t.Fatalf("GC failed: %v", err)
+	}
+	if !state.NewSnapshotStore().Exists(item.Snapshot) {
+		t.Fatal("GC removed a stashed snapshot")
+	}
+
+	if _, err := state.StashPop("", false); err != nil {
+		t.Fatalf("StashPop failed: %v", err)
+	}
+	st, _ := state.LoadStage()
+	if got := st.Items["post.md"]; got.Checksum != item.Checksum {
+		t.Errorf("popped checksum %q, want %q", got.Checksum, item.Checksum)
+	}
+	if stashes, _ := state.ListStashes(); len(stashes) != 0 {
+		t.Errorf("expected the popped stash to be dropped, %d left", len(stashes))
+	}
+}
+
+func TestStashPopDetectsConflicts(t *testing.T) {
+	setupTrackedProject(t)
+	write := func(body string) {
+		t.Helper()
+		if err := os.WriteFile("post.md", []byte("---\ntitle: Tracked Post\n---\n"+body), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := state.StageAdd("post.md"); err != nil {
+			t.Fatalf("StageAdd failed: %v", err)
+		}
+	}
+	write("First edit")
+	stashed, err := state.StashPush("", "first")
+	if err != nil {
+		t.Fatalf("StashPush failed: %v", err)
+	}
+	write("Second edit")
+
+	_, err = state.StashPop("", false)
+	var conflict *state.StashConflictError
+	if !errors.As(err, &conflict) || len(conflict.Paths) != 1 || conflict.Paths[0] != "post.md" {
+		t.Fatalf("expected a conflict on post.md, got %v", err)
+	}
+	if _, err := state.LoadStash(stashed.Name); err != nil {
+		t.Fatalf("a conflicting pop must keep the stash: %v", err)
+	}
+
+	if _, err := state.StashPop(stashed.Name, true); err != nil {
+		t.Fatalf("forced StashPop failed: %v", err)
+	}
+	st, _ := state.LoadStage()
+	if got := st.Items["post.md"].Checksum; got != stashed.Items["post.md"].Checksum {
+		t.Errorf("forced pop should restore the stashed item, got checksum %q", got)
+	}
+}
+
+func TestStashPopDetectsChangesAppliedSincePush(t *testing.T) {
+	setupTrackedProject(t)
+	if err := os.WriteFile("post.md", []byte("---\ntitle: Tracked Post\n---\nLong rewrite"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := state.StageAdd("post.md"); err != nil {
+		t.Fatal(err)
+	}
+	stashed, err := state.StashPush("rewrite", "")
+	if err != nil {
+		t.Fatalf("StashPush failed: %v", err)
+	}
+
+	// An urgent fix is staged and applied while the rewrite is stashed
+	fix := []byte("---\ntitle: Tracked Post\n---\nUrgent fix")
+	if err := os.WriteFile("post.md", fix, 0644); err != nil {
+		t.Fatal(err)
+	}
+	sum := mustLoadSum(t)
+	sum.SetArticle("post.md", "post-1", state.ChecksumFromContent(fix), "tracked-post")
+	sum.RecordPublish("post.md", state.ChecksumFromContent(fix), nil)
+	if err := state.SaveSum(sum); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = state.StashPop("", false)
+	var conflict *state.StashConflictError
+	if !errors.As(err, &conflict) || len(conflict.Applied) != 1 || conflict.Applied[0] != "post.md" || len(conflict.Paths) != 0 {
+		t.Fatalf("expected an applied-since conflict on post.md, got %v", err)
+	}
+	if st, _ := state.LoadStage(); len(st.Items) != 0 {
+		t.Fatalf("a conflicting pop must not restage: %v", st.Items)
+	}
+
+	if _, err := state.StashPop(stashed.Name, true); err != nil {
+		t.Fatalf("forced StashPop failed: %v", err)
+	}
+}
+
+func TestGCKeepsSnapshotsWhenAStashIsUnreadable(t *testing.T) {
+	setupTrackedProject(t)
+	if err := os.WriteFile("post.md", []byte("---\ntitle: Tracked Post\n---\nStashed edit"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := state.StageAdd("post.md"); err != nil {
+		t.Fatal(err)
+	}
+	staged, _ := state.LoadStage()
+	snapshot := staged.Items["post.md"].Snapshot
+	if _, err := state.StashPush("wip", ""); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(state.StatePath(state.StashDir, "broken"+state.StateFileExt), []byte("items: [unclosed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	store := state.NewSnapshotStore()
+	if _, err := store.GC(false); err == nil {
+		t.Error("expected GC to fail on an unreadable stash")
+	}
+	if _, err := store.GCWithVerification(false, true); err == nil {
+		t.Error("expected GCWithVerification to fail on an unreadable stash")
+	}
+	if !store.Exists(snapshot) {
+		t.Fatal("GC removed a stashed snapshot")
+	}
+
+	if err := os.WriteFile(state.SumFile, []byte("articles: [unclosed"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(state.StatePath(state.StashDir, "broken"+state.StateFileExt)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.GC(false); err == nil {
+		t.Error("expected GC to fail on an unreadable ledger")
+	}
+}
+
+func TestStashNamesCannotLeaveTheStashDirectory(t *testing.T) {
+	setupTrackedProject(t)
+	if err := os.WriteFile("hashnode.yml", []byte("plan:\n  rename_threshold: 0.6\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	name := "../../hashnode"
+	if err := state.DropStash(name); err == nil {
+		t.Error("expected DropStash to reject the name")
+	}
+	if _, err := state.LoadStash(name); err == nil {
+		t.Error("expected LoadStash to reject the name")
+	}
+	if _, err := state.StashPop(name, true); err == nil {
+		t.Error("expected StashPop to reject the name")
+	}
+	if _, err := os.Stat("hashnode.yml"); err != nil {
+		t.Fatalf("repo config removed: %v", err)
+	}
+}
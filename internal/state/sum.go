@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // Sum represents the deterministic mapping between repo artifacts and remote IDs
@@ -13,6 +15,16 @@ type Sum struct {
 	Blog     BlogEntry              `yaml:"blog"`
 	Series   map[string]SeriesEntry `yaml:"series"`
 	Articles map[string]ArticleSum  `yaml:"articles"`
+	Pages    map[string]PageSum     `yaml:"pages,omitempty"`    // Static pages under pages/, keyed by path
+	Webhooks map[string]WebhookSum  `yaml:"webhooks,omitempty"` // Keyed by name in webhooks.yml
+	Import   ImportEntry            `yaml:"import,omitempty"`
+}
+
+// ImportEntry records how far `hn import` has read the publication.
+type ImportEntry struct {
+	// High-water mark of the posts' remote updatedAt (publishedAt for posts
+	// never updated); `hn import --incremental` fetches only newer posts.
+	PostsUpdatedAt *time.Time `yaml:"posts_updated_at,omitempty"`
 }
 
 type BlogEntry struct {
@@ -21,10 +33,82 @@ type BlogEntry struct {
 }
 
 type ArticleSum struct {
-	PostID   string `yaml:"post_id"`
+	PostID    string          `yaml:"post_id"`
+	DraftID   string          `yaml:"draft_id,omitempty"` // Remote draft the file was imported from
+	Checksum  string          `yaml:"checksum"`
+	Slug      string          `yaml:"slug,omitempty"`
+	Title     string          `yaml:"title,omitempty"`     // Cached from frontmatter for display
+	Redirects []SlugRedirect  `yaml:"redirects,omitempty"` // Rules created for previous slugs
+	History   []PublishRecord `yaml:"history,omitempty"`   // Applied versions, oldest first
+}
+
+// HistoryRetention is how many of an article's latest publishes keep their
+// snapshot through GC. Older history entries stay in the ledger for `hn log`
+// but their content can no longer be shown.
+const HistoryRetention = 10
+
+// PublishRecord is one version of an article applied to Hashnode.
+type PublishRecord struct {
+	Checksum        string     `yaml:"checksum"` // Snapshot of the applied content
+	AppliedAt       time.Time  `yaml:"applied_at"`
+	RemoteUpdatedAt *time.Time `yaml:"remote_updated_at,omitempty"` // updatedAt reported by the API
+	Rollback        bool       `yaml:"rollback,omitempty"`          // Applied by `hn rollback`
+}
+
+// FindVersion resolves ref to a 1-based index into History. ref is a
+// checksum prefix of at least 6 characters or a version number, optionally
+// written "#n" as `hn log` prints it. A ref that could be either is tried as
+// a checksum first; the latest matching publish wins.
+func (a ArticleSum) FindVersion(ref string) (int, error) {
+	if n, ok := strings.CutPrefix(ref, "#"); ok {
+		return a.version(n)
+	}
+	if len(ref) >= 6 {
+		for n := len(a.History); n >= 1; n-- {
+			if strings.HasPrefix(a.History[n-1].Checksum, strings.ToLower(ref)) {
+				return n, nil
+			}
+		}
+	}
+	if _, err := strconv.Atoi(ref); err == nil {
+		return a.version(ref)
+	}
+	if len(ref) < 6 {
+		return 0, fmt.Errorf("checksum %q is too short; give at least 6 characters", ref)
+	}
+	return 0, fmt.Errorf("no published version has checksum %s", ref)
+}
+
+func (a ArticleSum) version(ref string) (int, error) {
+	n, err := strconv.Atoi(ref)
+	if err != nil {
+		return 0, fmt.Errorf("invalid version %q", ref)
+	}
+	if n < 1 || n > len(a.History) {
+		return 0, fmt.Errorf("version %d does not exist (1 to %d)", n, len(a.History))
+	}
+	return n, nil
+}
+
+// SlugRedirect records a redirection rule created when a post's slug changed.
+type SlugRedirect struct {
+	From   string `yaml:"from"` // Previous slug
+	RuleID string `yaml:"rule_id"`
+}
+
+// PageSum records a static page tracked from pages/.
+type PageSum struct {
+	PageID   string `yaml:"page_id"`
 	Checksum string `yaml:"checksum"`
 	Slug     string `yaml:"slug,omitempty"`
-	Title    string `yaml:"title,omitempty"` // Cached from frontmatter for display
+	Title    string `yaml:"title,omitempty"`
+}
+
+// WebhookSum records a webhook created from webhooks.yml. The API cannot list
+// webhooks, so this is the only link between a name and its remote ID.
+type WebhookSum struct {
+	WebhookID string `yaml:"webhook_id"`
+	Checksum  string `yaml:"checksum"` // WebhookChecksum of the applied definition
 }
 
 type SeriesEntry struct {
@@ -127,17 +211,45 @@ func (s *Sum) SetArticle(path, postID, checksum, slug string) {
 	s.Articles[path] = entry
 }
 
-// SetArticleWithTitle sets article entry including title cache
+// SetArticleWithTitle sets article entry including title cache. Other
+// fields (e.g. Redirects) are kept.
 func (s *Sum) SetArticleWithTitle(path, postID, checksum, slug, title string) {
 	if s.Articles == nil {
 		s.Articles = make(map[string]ArticleSum)
 	}
-	s.Articles[path] = ArticleSum{
-		PostID:   postID,
-		Checksum: checksum,
-		Slug:     slug,
-		Title:    title,
+	entry := s.Articles[path]
+	entry.PostID = postID
+	entry.Checksum = checksum
+	entry.Slug = slug
+	entry.Title = title
+	s.Articles[path] = entry
+}
+
+// SetDraft records a file imported from a remote draft. Other fields,
+// including a PostID set when the file was published, are kept.
+func (s *Sum) SetDraft(path, draftID, checksum, slug string) {
+	if s.Articles == nil {
+		s.Articles = make(map[string]ArticleSum)
 	}
+	entry := s.Articles[path]
+	entry.DraftID = draftID
+	entry.Checksum = checksum
+	entry.Slug = slug
+	s.Articles[path] = entry
+}
+
+// RecordPublish appends an applied version to the article's history.
+func (s *Sum) RecordPublish(path, checksum string, remoteUpdatedAt *time.Time) {
+	entry, ok := s.Articles[path]
+	if !ok {
+		return
+	}
+	entry.History = append(entry.History, PublishRecord{
+		Checksum:        checksum,
+		AppliedAt:       time.Now().UTC().Truncate(time.Second),
+		RemoteUpdatedAt: remoteUpdatedAt,
+	})
+	s.Articles[path] = entry
 }
 
 // RemoveArticle deletes an article entry from the sum
@@ -148,6 +260,54 @@ func (s *Sum) RemoveArticle(path string) {
 	delete(s.Articles, path)
 }
 
+// MoveArticle re-keys the ledger entry at from to to, keeping its PostID and
+// checksum. It reports whether from was tracked.
+func (s *Sum) MoveArticle(from, to string) bool {
+	entry, ok := s.Articles[from]
+	if !ok {
+		return false
+	}
+	delete(s.Articles, from)
+	s.Articles[to] = entry
+	return true
+}
+
+// MovePage re-keys the ledger entry of a static page like MoveArticle.
+func (s *Sum) MovePage(from, to string) bool {
+	entry, ok := s.Pages[from]
+	if !ok {
+		return false
+	}
+	delete(s.Pages, from)
+	s.Pages[to] = entry
+	return true
+}
+
+// AdvanceImportMark moves the incremental import mark forward to t. An
+// earlier t leaves it unchanged.
+func (s *Sum) AdvanceImportMark(t time.Time) {
+	if t.IsZero() {
+		return
+	}
+	if m := s.Import.PostsUpdatedAt; m != nil && !t.After(*m) {
+		return
+	}
+	t = t.UTC()
+	s.Import.PostsUpdatedAt = &t
+}
+
+// MergeSeries records remote series metadata under its slug. A description
+// already in the ledger is kept when the remote one is empty.
+func (s *Sum) MergeSeries(e SeriesEntry) {
+	if s.Series == nil {
+		s.Series = make(map[string]SeriesEntry)
+	}
+	if e.Description == "" {
+		e.Description = s.Series[e.Slug].Description
+	}
+	s.Series[e.Slug] = e
+}
+
 // SeriesSlug is a helper to deterministically produce a slug for series
 func SeriesSlug(name string) string {
 	s := strings.ToLower(strings.TrimSpace(name))
@@ -155,6 +315,20 @@ func SeriesSlug(name string) string {
 	return s
 }
 
+// FindSeries looks up a series by name or slug (case-insensitive).
+func (s *Sum) FindSeries(name string) (SeriesEntry, bool) {
+	if s == nil || len(s.Series) == 0 {
+		return SeriesEntry{}, false
+	}
+	slug := SeriesSlug(name)
+	for _, se := range s.Series {
+		if strings.EqualFold(se.Name, name) || strings.EqualFold(se.Slug, slug) {
+			return se, true
+		}
+	}
+	return SeriesEntry{}, false
+}
+
 // ValidateAgainstBlog ensures the sum's blog entry matches .hashnode/blog.yml
 func (s *Sum) ValidateAgainstBlog() error {
 	var blog struct {
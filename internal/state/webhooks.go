@@ -0,0 +1,102 @@
+package state
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// WebhooksFile declares the publication's webhooks. It lives at the repo root
+// and is staged like an article.
+const WebhooksFile = "webhooks.yml"
+
+// WebhookEvents are the event names accepted in webhooks.yml.
+var WebhookEvents = []string{
+	"POST_PUBLISHED",
+	"POST_UPDATED",
+	"POST_DELETED",
+	"STATIC_PAGE_PUBLISHED",
+	"STATIC_PAGE_EDITED",
+	"STATIC_PAGE_DELETED",
+}
+
+// Webhook is one entry in webhooks.yml. The secret itself is never committed;
+// SecretEnv names the environment variable that holds it at apply time.
+type Webhook struct {
+	Name      string   `yaml:"name"`
+	URL       string   `yaml:"url"`
+	Events    []string `yaml:"events"`
+	SecretEnv string   `yaml:"secret_env"`
+}
+
+// WebhooksDoc is the top-level structure of webhooks.yml.
+type WebhooksDoc struct {
+	Webhooks []Webhook `yaml:"webhooks"`
+}
+
+// ParseWebhooks decodes and validates webhooks.yml content. Event names are
+// normalized to upper case and sorted.
+func ParseWebhooks(content []byte) ([]Webhook, error) {
+	var doc WebhooksDoc
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", WebhooksFile, err)
+	}
+	known := make(map[string]bool, len(WebhookEvents))
+	for _, e := range WebhookEvents {
+		known[e] = true
+	}
+	seen := make(map[string]bool)
+	for i := range doc.Webhooks {
+		w := &doc.Webhooks[i]
+		w.Name = strings.TrimSpace(w.Name)
+		w.URL = strings.TrimSpace(w.URL)
+		w.SecretEnv = strings.TrimSpace(w.SecretEnv)
+		if w.Name == "" {
+			return nil, fmt.Errorf("%s: webhook %d: name is required", WebhooksFile, i+1)
+		}
+		if seen[w.Name] {
+			return nil, fmt.Errorf("%s: duplicate webhook name %s", WebhooksFile, w.Name)
+		}
+		seen[w.Name] = true
+		if u, err := url.Parse(w.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("%s: %s: url must be an absolute http(s) URL", WebhooksFile, w.Name)
+		}
+		if len(w.Events) == 0 {
+			return nil, fmt.Errorf("%s: %s: at least one event is required", WebhooksFile, w.Name)
+		}
+		for j, e := range w.Events {
+			e = strings.ToUpper(strings.TrimSpace(e))
+			if !known[e] {
+				return nil, fmt.Errorf("%s: %s: unknown event %q (valid: %s)", WebhooksFile, w.Name, w.Events[j], strings.Join(WebhookEvents, ", "))
+			}
+			w.Events[j] = e
+		}
+		sort.Strings(w.Events)
+		if w.SecretEnv == "" {
+			return nil, fmt.Errorf("%s: %s: secret_env is required", WebhooksFile, w.Name)
+		}
+	}
+	return doc.Webhooks, nil
+}
+
+// Secret returns the webhook secret from the environment.
+func (w Webhook) Secret() (string, error) {
+	secret := os.Getenv(w.SecretEnv)
+	if secret == "" {
+		return "", fmt.Errorf("webhook %s: environment variable %s is not set", w.Name, w.SecretEnv)
+	}
+	return secret, nil
+}
+
+// WebhookChecksum fingerprints a webhook definition for change detection. It
+// covers the secret's variable name, not its value, so rotating a secret under
+// the same name is not detected.
+func WebhookChecksum(w Webhook) string {
+	events := append([]string(nil), w.Events...)
+	sort.Strings(events)
+	return ChecksumFromContent([]byte(strings.Join([]string{w.URL, strings.Join(events, ","), w.SecretEnv}, "\n")))
+}
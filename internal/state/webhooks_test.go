@@ -0,0 +1,40 @@
+package state_test
+
+import (
+	"testing"
+
+	"adil-adysh/hashnode-cli/internal/state"
+)
+
+func TestParseWebhooks(t *testing.T) {
+	hooks, err := state.ParseWebhooks([]byte("webhooks:\n  - name: deploy\n    url: https://ci.example.com/hook\n    events: [post_updated, POST_PUBLISHED]\n    secret_env: HOOK_SECRET\n"))
+	if err != nil {
+		t.Fatalf("ParseWebhooks failed: %v", err)
+	}
+	if len(hooks) != 1 || hooks[0].Events[0] != "POST_PUBLISHED" || hooks[0].Events[1] != "POST_UPDATED" {
+		t.Errorf("events not normalized: %+v", hooks)
+	}
+
+	for name, doc := range map[string]string{
+		"relative url":  "webhooks:\n  - name: a\n    url: /hook\n    events: [POST_UPDATED]\n    secret_env: S\n",
+		"unknown event": "webhooks:\n  - name: a\n    url: https://x.dev\n    events: [POST_LIKED]\n    secret_env: S\n",
+		"no secret":     "webhooks:\n  - name: a\n    url: https://x.dev\n    events: [POST_UPDATED]\n",
+		"duplicate":     "webhooks:\n  - name: a\n    url: https://x.dev\n    events: [POST_UPDATED]\n    secret_env: S\n  - name: a\n    url: https://y.dev\n    events: [POST_UPDATED]\n    secret_env: S\n",
+	} {
+		if _, err := state.ParseWebhooks([]byte(doc)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestWebhookChecksumIgnoresEventOrder(t *testing.T) {
+	a := state.Webhook{URL: "https://x.dev", Events: []string{"POST_UPDATED", "POST_DELETED"}, SecretEnv: "S"}
+	b := state.Webhook{URL: "https://x.dev", Events: []string{"POST_DELETED", "POST_UPDATED"}, SecretEnv: "S"}
+	if state.WebhookChecksum(a) != state.WebhookChecksum(b) {
+		t.Error("event order changed the checksum")
+	}
+	b.SecretEnv = "OTHER"
+	if state.WebhookChecksum(a) == state.WebhookChecksum(b) {
+		t.Error("secret_env change not detected")
+	}
+}